@@ -4,8 +4,6 @@
 // generated from Google's Address Data Service
 package libaddress
 
-import "fmt"
-
 // Address represents a valid address made up of its
 // child components
 type Address struct {
@@ -32,9 +30,8 @@ func New(fields ...func(*Address)) Address {
 
 // NewValid creates a new Address. If the address is invalid, an error
 // is returned. In the case where an error is returned, the error is a
-// hashicorp/go-multierror (https://github.com/hashicorp/go-multierror).
-// You can use a type switch to get a list of validation errors for
-// the address.
+// ValidationErrors. You can use errors.As to get a list of validation
+// errors for the address.
 func NewValid(fields ...func(*Address)) (Address, error) {
 
 	address := New(fields...)
@@ -42,8 +39,56 @@ func NewValid(fields ...func(*Address)) (Address, error) {
 	err := Validate(address)
 
 	if err != nil {
-		return address, fmt.Errorf("%s", err)
+		return address, err
 	}
 
 	return address, nil
 }
+
+// getField returns the value of a field of an address. The street
+// address is not a single value, so it cannot be returned.
+func getField(address Address, field Field) string {
+	switch field {
+	case Country:
+		return address.Country
+	case Name:
+		return address.Name
+	case Organization:
+		return address.Organization
+	case DependentLocality:
+		return address.DependentLocality
+	case Locality:
+		return address.Locality
+	case AdministrativeArea:
+		return address.AdministrativeArea
+	case PostCode:
+		return address.PostCode
+	case SortingCode:
+		return address.SortingCode
+	default:
+		return ""
+	}
+}
+
+// setField sets the value of a field of an address. The street
+// address is not a single value, so it cannot be set.
+func setField(address *Address, field Field, value string) {
+	switch field {
+	case Country:
+		address.Country = value
+	case Name:
+		address.Name = value
+	case Organization:
+		address.Organization = value
+	case DependentLocality:
+		address.DependentLocality = value
+	case Locality:
+		address.Locality = value
+	case AdministrativeArea:
+		address.AdministrativeArea = value
+	case PostCode:
+		address.PostCode = value
+	case SortingCode:
+		address.SortingCode = value
+	}
+}
@@ -1,6 +1,7 @@
 package libaddress
 
 import (
+	"errors"
 	"log"
 	"reflect"
 	"testing"
@@ -136,6 +137,42 @@ func TestValidAddresses(t *testing.T) {
 				PostCode:           "37592",
 			},
 		},
+		{
+			Address: []func(*Address){
+				WithStreetAddress([]string{
+					"1 Calle Fortaleza",
+				}),
+				WithLocality("San Juan"),
+				WithPostCode("PR 00901"), // Post code with prefix
+				WithCountry("PR"),
+			},
+			Expected: Address{
+				Country: "PR",
+				StreetAddress: []string{
+					"1 Calle Fortaleza",
+				},
+				Locality: "San Juan",
+				PostCode: "PR 00901",
+			},
+		},
+		{
+			Address: []func(*Address){
+				WithStreetAddress([]string{
+					"Carrer Major 1",
+				}),
+				WithLocality("Escaldes-Engordany"),
+				WithPostCode("AD700"),
+				WithCountry("AD"),
+			},
+			Expected: Address{
+				Country: "AD",
+				StreetAddress: []string{
+					"Carrer Major 1",
+				},
+				Locality: "Escaldes-Engordany",
+				PostCode: "AD700",
+			},
+		},
 	}
 
 	for i, testCase := range tests {
@@ -257,6 +294,136 @@ func TestInvalidAddresses(t *testing.T) {
 	}
 }
 
+func TestValidationErrors(t *testing.T) {
+
+	_, err := NewValid(
+		WithLocality("Melbourne"),       // Missing street address
+		WithDependentLocality("Toorak"), // Extraneous field
+		WithAdministrativeArea("VIC"),
+		WithPostCode("2000"), // Post code in NSW
+		WithCountry("AU"),
+	)
+
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected a ValidationErrors, got %v", err)
+	}
+
+	expected := ValidationErrors{
+		{Field: StreetAddress, Code: CodeMissing},
+		{Field: DependentLocality, Code: CodeUnsupported, Value: "Toorak"},
+		{
+			Field:     PostCode,
+			Code:      CodeMismatch,
+			Value:     "2000",
+			Level:     AdministrativeArea,
+			Err:       ErrPostCodeAdministrativeAreaMismatch,
+			BelongsTo: []SubdivisionPath{{AdministrativeArea: "NSW"}},
+		},
+	}
+
+	if !reflect.DeepEqual(validationErrors, expected) {
+		t.Errorf("Validation errors do not match expected errors, got %s", validationErrors)
+	}
+
+	if !errors.Is(err, ErrInvalidPostCode) {
+		t.Errorf("Expected validation errors to contain ErrInvalidPostCode")
+	}
+
+	if errors.Is(err, ErrInvalidAdministrativeArea) {
+		t.Errorf("Expected validation errors to not contain ErrInvalidAdministrativeArea")
+	}
+
+	var missing ErrMissingRequiredFields
+	if !errors.As(err, &missing) || !reflect.DeepEqual(missing.Fields, []Field{StreetAddress}) {
+		t.Errorf("Expected missing required fields to be StreetAddress, got %v", missing.Fields)
+	}
+
+	var unsupported ErrUnsupportedFields
+	if !errors.As(err, &unsupported) || !reflect.DeepEqual(unsupported.Fields, []Field{DependentLocality}) {
+		t.Errorf("Expected unsupported fields to be DependentLocality, got %v", unsupported.Fields)
+	}
+
+	err = Validate(New(WithCountry("XX")))
+
+	if !errors.As(err, &validationErrors) || validationErrors[0].Field != Country || validationErrors[0].Code != CodeInvalidValue {
+		t.Errorf("Expected an invalid value error for the country, got %v", err)
+	}
+
+	if !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("Expected validation errors to contain ErrInvalidCountryCode")
+	}
+}
+
+func TestPostCodeMismatch(t *testing.T) {
+
+	tests := []struct {
+		Address   Address
+		Err       error
+		Level     Field
+		BelongsTo []SubdivisionPath
+	}{
+		{
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("300O"), // Malformed post code
+				WithCountry("AU"),
+			),
+			Err: ErrInvalidPostCode,
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{"Jangnyang-ro 17beon-gil"}),
+				WithDependentLocality("북구"),
+				WithLocality("포항시"),
+				WithAdministrativeArea("47"),
+				WithPostCode("38100"), // Post code in 경주시
+				WithCountry("KR"),
+			),
+			Err:       ErrPostCodeLocalityMismatch,
+			Level:     Locality,
+			BelongsTo: []SubdivisionPath{{AdministrativeArea: "47", Locality: "경주시"}},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{"Jangnyang-ro 17beon-gil"}),
+				WithDependentLocality("남구"),
+				WithLocality("포항시"),
+				WithAdministrativeArea("47"),
+				WithPostCode("37554"), // Post code in 북구
+				WithCountry("KR"),
+			),
+			Err:       ErrPostCodeDependentLocalityMismatch,
+			Level:     DependentLocality,
+			BelongsTo: []SubdivisionPath{{AdministrativeArea: "47", Locality: "포항시", DependentLocality: "북구"}},
+		},
+	}
+
+	for i, testCase := range tests {
+		var validationErrors ValidationErrors
+		if err := Validate(testCase.Address); !errors.As(err, &validationErrors) {
+			t.Errorf("Expected validation errors in test case %d, got %v", i, err)
+			continue
+		}
+
+		postCodeErr := validationErrors[len(validationErrors)-1]
+
+		if postCodeErr.Err != testCase.Err || postCodeErr.Level != testCase.Level {
+			t.Errorf("Expected %s in test case %d, got %s", testCase.Err, i, postCodeErr)
+		}
+
+		if !reflect.DeepEqual(postCodeErr.BelongsTo, testCase.BelongsTo) {
+			t.Errorf("Post code in test case %d does not belong to the expected subdivisions, got %v", i, postCodeErr.BelongsTo)
+		}
+
+		if !errors.Is(postCodeErr, ErrInvalidPostCode) {
+			t.Errorf("Expected the error in test case %d to be an ErrInvalidPostCode", i)
+		}
+	}
+}
+
 func TestGetCountries(t *testing.T) {
 
 	countries := ListCountries("en")
@@ -275,6 +442,10 @@ func TestGetCountries(t *testing.T) {
 				t.Errorf("Expected English name of Australia to be Australia, got %s", country.Name)
 				break
 			}
+
+			if country.Alpha3 != "AUS" || country.Numeric != "036" {
+				t.Errorf("Expected ISO codes of Australia to be AUS and 036, got %s and %s", country.Alpha3, country.Numeric)
+			}
 		}
 	}
 
@@ -316,7 +487,8 @@ func TestGetCountry(t *testing.T) {
 		DependentLocalityNameType:  Suburb,
 		PostCodeNameType:           PostalCode,
 		PostCodeRegex: PostCodeRegexData{
-			Regex: `\d{4}`,
+			Regex:    `\d{4}`,
+			Examples: []string{"2060", "3171", "6430", "4000", "4006", "3001"},
 			SubdivisionRegex: map[string]PostCodeRegexData{
 				"ACT": {
 					Regex: `^29|2540|260|261[0-8]|02|2620`},
@@ -336,6 +508,8 @@ func TestGetCountry(t *testing.T) {
 					Regex: `^6|0872`},
 			},
 		},
+		PostCodeMask: "9999",
+		PostCodeHint: "e.g. 2060, 3171",
 		AdministrativeAreas: map[string]AdministrativeAreaSlice{
 			"en": {
 				{
@@ -394,8 +568,11 @@ func TestGetCountry(t *testing.T) {
 		DependentLocalityNameType:  Suburb,
 		PostCodeNameType:           PostalCode,
 		PostCodeRegex: PostCodeRegexData{
-			Regex: `ASCN 1ZZ`,
+			Regex:    `ASCN 1ZZ`,
+			Examples: []string{"ASCN 1ZZ"},
 		},
+		PostCodeMask: `\ASCN \1ZZ`,
+		PostCodeHint: "e.g. ASCN 1ZZ",
 	}
 
 	if !reflect.DeepEqual(country, expected) {
@@ -419,7 +596,8 @@ func TestGetCountry(t *testing.T) {
 		DependentLocalityNameType:  District,
 		PostCodeNameType:           PostalCode,
 		PostCodeRegex: PostCodeRegexData{
-			Regex: `\d{5}`,
+			Regex:    `\d{5}`,
+			Examples: []string{"03051"},
 			SubdivisionRegex: map[string]PostCodeRegexData{
 				"11": {
 					Regex: `^0[1-8]\d{2}`,
@@ -1039,6 +1217,8 @@ func TestGetCountry(t *testing.T) {
 					Regex: `^30[01]\d`},
 			},
 		},
+		PostCodeMask: "99999",
+		PostCodeHint: "e.g. 03051",
 		AdministrativeAreas: map[string]AdministrativeAreaSlice{
 			"en": {
 				{
@@ -3559,3 +3739,96 @@ func TestGetCountry(t *testing.T) {
 		t.Errorf("Country data for KR does not match expected country data")
 	}
 }
+
+func BenchmarkValidate(b *testing.B) {
+
+	address := New(
+		WithStreetAddress([]string{
+			"Jangnyang-ro 17beon-gil",
+		}),
+		WithDependentLocality("북구"),
+		WithLocality("포항시"),
+		WithAdministrativeArea("47"),
+		WithPostCode("37554"),
+		WithCountry("KR"),
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := Validate(address); err != nil {
+			b.Fatalf("Unexpected error validating address: %s", err)
+		}
+	}
+}
+
+func TestCheckPostCodeAllocations(t *testing.T) {
+
+	if raceEnabled {
+		t.Skip("The race detector allocates when checking post codes")
+	}
+
+	address := New(
+		WithDependentLocality("북구"),
+		WithLocality("포항시"),
+		WithAdministrativeArea("47"),
+		WithPostCode("37554"),
+		WithCountry("KR"),
+	)
+
+	regex := generated.getCountry("KR").PostCodeRegex
+
+	allocs := testing.AllocsPerRun(100, func() {
+		checkPostCode(address, regex, true)
+	})
+
+	if allocs > 0 {
+		t.Errorf("Expected checking a valid post code to not allocate, got %v allocations", allocs)
+	}
+}
+
+func BenchmarkCheckPostCode(b *testing.B) {
+
+	address := New(
+		WithDependentLocality("북구"),
+		WithLocality("포항시"),
+		WithAdministrativeArea("47"),
+		WithPostCode("37554"),
+		WithCountry("KR"),
+	)
+
+	regex := generated.getCountry("KR").PostCodeRegex
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := checkPostCode(address, regex, true); err != nil {
+			b.Fatalf("Unexpected error checking post code: %s", err)
+		}
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+
+	text := "677000\n云南省临沧市临翔区\nNo.1 Jianguomenwai Avenue"
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse("CN", text); err != nil {
+			b.Fatalf("Unexpected error parsing address: %s", err)
+		}
+	}
+}
+
+func BenchmarkGetLocalityName(b *testing.B) {
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		generated.getLocalityName("CN", "53", "临沧市", "zh")
+	}
+}
@@ -0,0 +1,103 @@
+package libaddress
+
+import (
+	"fmt"
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// NormalizeCountryCode converts an ISO 3166-1 alpha-2 (AU), alpha-3
+// (AUS) or numeric (036) country code to the alpha-2 code used by
+// addresses. The code is case insensitive. ErrInvalidCountryCode is
+// returned if the code does not match a country.
+func NormalizeCountryCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	switch len(code) {
+	case 2:
+		if code != "ZZ" && generated.hasCountry(code) {
+			return code, nil
+		}
+
+	case 3:
+		region, err := language.ParseRegion(code)
+		if err != nil {
+			break
+		}
+
+		cc := region.String()
+		if !generated.hasCountry(cc) {
+			break
+		}
+
+		// Only accept official codes, as the region parser
+		// also accepts codes such as XKK for Kosovo.
+		if alpha3, numeric := isoCountryCodes(cc); code == alpha3 || code == numeric {
+			return cc, nil
+		}
+	}
+
+	return "", ErrInvalidCountryCode
+}
+
+// CountryAlpha3 returns the ISO 3166-1 alpha-3 code of a country, such
+// as AUS for AU. The country can be given in any form accepted by
+// NormalizeCountryCode. ErrInvalidCountryCode is returned if the
+// country does not exist and ErrNoISOCountryCode is returned if it
+// does not have an alpha-3 code, such as Kosovo (XK) and Ascension
+// Island (AC).
+func CountryAlpha3(cc string) (string, error) {
+	cc, err := NormalizeCountryCode(cc)
+	if err != nil {
+		return "", err
+	}
+
+	alpha3, _ := isoCountryCodes(cc)
+	if alpha3 == "" {
+		return "", ErrNoISOCountryCode
+	}
+
+	return alpha3, nil
+}
+
+// CountryNumeric returns the ISO 3166-1 numeric code of a country,
+// such as 036 for AU. The country can be given in any form accepted by
+// NormalizeCountryCode. ErrInvalidCountryCode is returned if the
+// country does not exist and ErrNoISOCountryCode is returned if it
+// does not have a numeric code.
+func CountryNumeric(cc string) (string, error) {
+	cc, err := NormalizeCountryCode(cc)
+	if err != nil {
+		return "", err
+	}
+
+	_, numeric := isoCountryCodes(cc)
+	if numeric == "" {
+		return "", ErrNoISOCountryCode
+	}
+
+	return numeric, nil
+}
+
+// isoCountryCodes returns the alpha-3 and numeric codes of a country.
+// Numeric codes from 900 are user-assigned and codes that are only
+// reserved, such as AC, do not have a numeric code. Neither has an
+// official alpha-3 code.
+func isoCountryCodes(cc string) (alpha3, numeric string) {
+	region, err := language.ParseRegion(cc)
+	if err != nil || region.M49() < 1 || region.M49() > 899 {
+		return "", ""
+	}
+
+	return region.ISO3(), fmt.Sprintf("%03d", region.M49())
+}
+
+// countryCode normalizes a country code to its alpha-2 code. Invalid
+// codes are only uppercased, so that they are reported by validation.
+func countryCode(cc string) string {
+	if normalized, err := NormalizeCountryCode(cc); err == nil {
+		return normalized
+	}
+
+	return strings.ToUpper(cc)
+}
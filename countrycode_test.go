@@ -0,0 +1,150 @@
+package libaddress
+
+import (
+	"testing"
+)
+
+func TestNormalizeCountryCode(t *testing.T) {
+
+	tests := []struct {
+		Code     string
+		Expected string
+		Err      error
+	}{
+		{Code: "AU", Expected: "AU"},
+		{Code: "au", Expected: "AU"},
+		{Code: "AUS", Expected: "AU"},
+		{Code: "aus", Expected: "AU"},
+		{Code: "036", Expected: "AU"},
+		{Code: " 840 ", Expected: "US"},
+		{Code: "GBR", Expected: "GB"},
+		{Code: "XK", Expected: "XK"},
+		{Code: "XKK", Err: ErrInvalidCountryCode}, // Not an official code
+		{Code: "36", Err: ErrInvalidCountryCode},
+		{Code: "001", Err: ErrInvalidCountryCode}, // The world
+		{Code: "ZZ", Err: ErrInvalidCountryCode},
+		{Code: "XX", Err: ErrInvalidCountryCode},
+		{Code: "", Err: ErrInvalidCountryCode},
+	}
+
+	for i, testCase := range tests {
+		cc, err := NormalizeCountryCode(testCase.Code)
+
+		if err != testCase.Err {
+			t.Errorf("Expected error %v in test case %d, got %v", testCase.Err, i, err)
+		}
+
+		if cc != testCase.Expected {
+			t.Errorf("Country code in test case %d does not match expected country code %s, got %s", i, testCase.Expected, cc)
+		}
+	}
+}
+
+func TestCountryCodeConversions(t *testing.T) {
+
+	tests := []struct {
+		Code    string
+		Alpha3  string
+		Numeric string
+		Err     error
+	}{
+		{Code: "AU", Alpha3: "AUS", Numeric: "036"},
+		{Code: "036", Alpha3: "AUS", Numeric: "036"},
+		{Code: "JPN", Alpha3: "JPN", Numeric: "392"},
+		{Code: "XK", Err: ErrNoISOCountryCode},
+		{Code: "AC", Err: ErrNoISOCountryCode},
+		{Code: "XX", Err: ErrInvalidCountryCode},
+	}
+
+	for i, testCase := range tests {
+		alpha3, err := CountryAlpha3(testCase.Code)
+		if err != testCase.Err || alpha3 != testCase.Alpha3 {
+			t.Errorf("Expected alpha-3 code %s and error %v in test case %d, got %s and %v", testCase.Alpha3, testCase.Err, i, alpha3, err)
+		}
+
+		numeric, err := CountryNumeric(testCase.Code)
+		if err != testCase.Err || numeric != testCase.Numeric {
+			t.Errorf("Expected numeric code %s and error %v in test case %d, got %s and %v", testCase.Numeric, testCase.Err, i, numeric, err)
+		}
+	}
+
+	// Every country with ISO codes must round trip.
+	for _, country := range ListCountries("en") {
+		for _, code := range []string{country.Alpha3, country.Numeric} {
+			if code == "" {
+				continue
+			}
+
+			if cc, err := NormalizeCountryCode(code); err != nil || cc != country.Code {
+				t.Errorf("ISO code %s of %s does not round trip, got %s and %v", code, country.Code, cc, err)
+			}
+		}
+	}
+}
+
+func TestCountryCodeValidation(t *testing.T) {
+
+	for _, cc := range []string{"AU", "AUS", "036"} {
+		address := New(
+			WithCountry(cc),
+			WithStreetAddress([]string{"525 Collins Street"}),
+			WithLocality("Melbourne"),
+			WithAdministrativeArea("VIC"),
+			WithPostCode("3000"),
+		)
+
+		if address.Country != "AU" {
+			t.Errorf("Expected country code %s to be set as AU, got %s", cc, address.Country)
+		}
+
+		address.Country = cc
+		if err := Validate(address); err != nil {
+			t.Errorf("Unexpected error validating address with country code %s: %s", cc, err)
+		}
+
+		if err := NewValidator(AllowedCountries("AUS")).Validate(address); err != nil {
+			t.Errorf("Unexpected error validating address with country code %s in allowed countries: %s", cc, err)
+		}
+	}
+}
+
+func TestCountryCodeInputs(t *testing.T) {
+
+	for _, cc := range []string{"AU", "aus", "036"} {
+		if name := AdministrativeAreaName(cc, "VIC", "en"); name != "Victoria" {
+			t.Errorf("Expected administrative area name for country code %s to be Victoria, got %s", cc, name)
+		}
+
+		if areas := AdministrativeAreas(cc, "en"); len(areas) != len(AdministrativeAreas("AU", "en")) {
+			t.Errorf("Expected administrative areas for country code %s to match AU, got %v", cc, areas)
+		}
+
+		if results := SearchSubdivisions(cc, "vic", "en", 1); len(results) != 1 || results[0].Path[0].ID != "VIC" {
+			t.Errorf("Expected search for country code %s to return VIC, got %v", cc, results)
+		}
+
+		if postCode, err := NormalizePostCode(cc, " 3000 "); err != nil || postCode != "3000" {
+			t.Errorf("Expected post code for country code %s to be normalized to 3000, got %s and %v", cc, postCode, err)
+		}
+
+		if mask := PostCodeMask(cc); mask != "9999" {
+			t.Errorf("Expected post code mask for country code %s to be 9999, got %s", cc, mask)
+		}
+
+		if code, err := SubdivisionISOCode(cc, "VIC"); err != nil || code != "AU-VIC" {
+			t.Errorf("Expected ISO code for country code %s to be AU-VIC, got %s and %v", cc, code, err)
+		}
+
+		if _, err := InferSubdivisions(cc, "3000"); err != nil {
+			t.Errorf("Unexpected error inferring subdivisions for country code %s: %s", cc, err)
+		}
+
+		if address, err := Parse(cc, "525 Collins Street\nMELBOURNE VIC 3000"); err != nil || address.Country != "AU" {
+			t.Errorf("Expected parsed address for country code %s to be in AU, got %s and %v", cc, address.Country, err)
+		}
+
+		if country := GetCountry(cc); country.Format != GetCountry("AU").Format {
+			t.Errorf("Expected country data for country code %s to match AU", cc)
+		}
+	}
+}
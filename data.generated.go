@@ -7,7 +7,8 @@ var generated = data{
 		Name:            "ASCENSION ISLAND",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `ASCN 1ZZ`},
+			regex:    `ASCN 1ZZ`,
+			examples: []string{"ASCN 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -20,7 +21,8 @@ var generated = data{
 		Name:            "ANDORRA",
 		DefaultLanguage: "ca",
 		PostCodeRegex: postCodeRegex{
-			regex: `AD[1-7]0\d`,
+			regex:    `AD[1-7]0\d`,
+			examples: []string{"AD100", "AD501", "AD700"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"02": {
 					regex: `^AD10[01]`}, "03": {
@@ -172,7 +174,8 @@ var generated = data{
 		Name:            "AFGHANISTAN",
 		DefaultLanguage: "fa",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1001", "2601", "3801"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -191,7 +194,8 @@ var generated = data{
 		Name:            "ANGUILLA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:AI-)?2640`},
+			regex:    `(?:AI-)?2640`,
+			examples: []string{"2640"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -204,7 +208,8 @@ var generated = data{
 		Name:            "ALBANIA",
 		DefaultLanguage: "sq",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1001", "1017", "3501"}},
 		Format: "%N%n%O%n%A%n%Z%n%C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -217,7 +222,8 @@ var generated = data{
 		Name:            "ARMENIA",
 		DefaultLanguage: "hy",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:37)?\d{4}`,
+			regex:    `(?:37)?\d{4}`,
+			examples: []string{"375010", "0002", "0010"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AG": {
 					regex: `^0[2-5]`}, "AR": {
@@ -369,7 +375,8 @@ var generated = data{
 		Name:            "ARGENTINA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `((?:[A-HJ-NP-Z])?\d{4})([A-Z]{3})?`,
+			regex:    `((?:[A-HJ-NP-Z])?\d{4})([A-Z]{3})?`,
+			examples: []string{"C1070AAM", "C1000WAM", "B1000TBU", "X5187XAB"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"A": {
 					regex: `^A?[34]`}, "B": {
@@ -538,7 +545,8 @@ var generated = data{
 		Name:            "AMERICAN SAMOA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(96799)(?:[ \-](\d{4}))?`},
+			regex:    `(96799)(?:[ \-](\d{4}))?`,
+			examples: []string{"96799"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -566,7 +574,8 @@ var generated = data{
 		Name:            "AUSTRIA",
 		DefaultLanguage: "de",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1010", "3741"}},
 		Format: "%O%n%N%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -583,7 +592,8 @@ var generated = data{
 		Name:            "AUSTRALIA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`,
+			regex:    `\d{4}`,
+			examples: []string{"2060", "3171", "6430", "4000", "4006", "3001"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"ACT": {
 					regex: `^29|2540|260|261[0-8]|02|2620`}, "NSW": {
@@ -666,7 +676,8 @@ var generated = data{
 		Name:            "FINLAND",
 		DefaultLanguage: "sv",
 		PostCodeRegex: postCodeRegex{
-			regex: `22\d{3}`},
+			regex:    `22\d{3}`,
+			examples: []string{"22150", "22550", "22240", "22710", "22270", "22730", "22430"}},
 		Format: "%O%n%N%n%A%nAX-%Z %C%nÅLAND",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -683,7 +694,8 @@ var generated = data{
 		Name:            "AZERBAIJAN",
 		DefaultLanguage: "az",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1000"}},
 		Format: "%N%n%O%n%A%nAZ %Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -696,7 +708,8 @@ var generated = data{
 		Name:            "BOSNIA AND HERZEGOVINA",
 		DefaultLanguage: "bs",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"71000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -709,7 +722,8 @@ var generated = data{
 		Name:            "BARBADOS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `BB\d{5}`},
+			regex:    `BB\d{5}`,
+			examples: []string{"BB23026", "BB22025"}},
 		Format:                     "%N%n%O%n%A%n%C, %S %Z",
 		AdministrativeAreaNameType: Parish,
 		AllowedFields: map[Field]struct{}{
@@ -724,7 +738,8 @@ var generated = data{
 		Name:            "BANGLADESH",
 		DefaultLanguage: "bn",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1340", "1000"}},
 		Format: "%N%n%O%n%A%n%C - %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -737,7 +752,8 @@ var generated = data{
 		Name:            "BELGIUM",
 		DefaultLanguage: "nl",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"4000", "1000"}},
 		Format: "%O%n%N%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -765,7 +781,8 @@ var generated = data{
 		Name:            "BULGARIA (REP.)",
 		DefaultLanguage: "bg",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1000", "1700"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -778,7 +795,8 @@ var generated = data{
 		Name:            "BAHRAIN",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:\d|1[0-2])\d{2}`},
+			regex:    `(?:\d|1[0-2])\d{2}`,
+			examples: []string{"317"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -803,7 +821,8 @@ var generated = data{
 		Name:            "SAINT BARTHELEMY",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78][01]\d{2}`},
+			regex:    `9[78][01]\d{2}`,
+			examples: []string{"97100"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -826,7 +845,8 @@ var generated = data{
 		Name:            "BERMUDA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `[A-Z]{2} ?[A-Z0-9]{2}`},
+			regex:    `[A-Z]{2} ?[A-Z0-9]{2}`,
+			examples: []string{"FL 07", "HM GX", "HM 12"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -839,7 +859,8 @@ var generated = data{
 		Name:            "BRUNEI DARUSSALAM",
 		DefaultLanguage: "ms",
 		PostCodeRegex: postCodeRegex{
-			regex: `[A-Z]{2} ?\d{4}`},
+			regex:    `[A-Z]{2} ?\d{4}`,
+			examples: []string{"BT2328", "KA1131", "BA1511"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -864,7 +885,8 @@ var generated = data{
 		Name:            "BRAZIL",
 		DefaultLanguage: "pt",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}-?\d{3}`,
+			regex:    `\d{5}-?\d{3}`,
+			examples: []string{"40301-110", "70002-900"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AC": {
 					regex: `^699`}, "AL": {
@@ -23457,7 +23479,8 @@ var generated = data{
 		Name:            "BHUTAN",
 		DefaultLanguage: "dz",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"11001", "31101", "35003"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -23478,7 +23501,8 @@ var generated = data{
 		Name:            "BELARUS",
 		DefaultLanguage: "be",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"223016", "225860", "220050"}},
 		Format: "%S%n%Z %C%n%A%n%O%n%N",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -23496,7 +23520,8 @@ var generated = data{
 		Name:            "CANADA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `[ABCEGHJKLMNPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`,
+			regex:    `[ABCEGHJKLMNPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`,
+			examples: []string{"H3Z 2Y7", "V8X 3X4", "T0L 1K0", "T0H 1A0", "K1A 0B1"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AB": {
 					regex: `^T`}, "BC": {
@@ -23674,7 +23699,8 @@ var generated = data{
 		Name:            "COCOS (KEELING) ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `6799`},
+			regex:    `6799`,
+			examples: []string{"6799"}},
 		Format: "%O%n%N%n%A%n%C %S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -23704,7 +23730,8 @@ var generated = data{
 		Name:            "SWITZERLAND",
 		DefaultLanguage: "de",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"2544", "1211", "1556", "3030"}},
 		Format: "%O%n%N%n%A%nCH-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -23736,7 +23763,8 @@ var generated = data{
 		Name:            "CHILE",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{7}`},
+			regex:    `\d{7}`,
+			examples: []string{"8340457", "8720019", "1230000", "8329100"}},
 		Format: "%N%n%O%n%A%n%Z %C%n%S",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -25247,7 +25275,8 @@ var generated = data{
 		Name:            "CHINA",
 		DefaultLanguage: "zh",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"266033", "317204", "100096", "100808"}},
 		Format:                    "%Z%n%S%C%D%n%A%n%O%n%N",
 		LatinizedFormat:           "%N%n%O%n%A%n%D%n%C%n%S, %Z",
 		DependentLocalityNameType: District,
@@ -52528,7 +52557,8 @@ var generated = data{
 		Name:            "COLOMBIA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"111221", "130001", "760011"}},
 		Format:                     "%N%n%O%n%A%n%C, %S, %Z",
 		AdministrativeAreaNameType: Department,
 		AllowedFields: map[Field]struct{}{
@@ -52546,7 +52576,8 @@ var generated = data{
 		Name:            "COSTA RICA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4,5}|\d{3}-\d{4}`},
+			regex:    `\d{4,5}|\d{3}-\d{4}`,
+			examples: []string{"1000", "2010", "1001"}},
 		Format: "%N%n%O%n%A%n%S, %C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -52564,7 +52595,8 @@ var generated = data{
 		Name:            "CUBA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"10700"}},
 		Format: "%N%n%O%n%A%n%C %S%n%Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -52662,7 +52694,8 @@ var generated = data{
 		Name:            "CAPE VERDE",
 		DefaultLanguage: "pt",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"7600"}},
 		Format:                     "%N%n%O%n%A%n%Z %C%n%S",
 		AdministrativeAreaNameType: Island,
 		AllowedFields: map[Field]struct{}{
@@ -52710,7 +52743,8 @@ var generated = data{
 		Name:            "CHRISTMAS ISLAND",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `6798`},
+			regex:    `6798`,
+			examples: []string{"6798"}},
 		Format: "%O%n%N%n%A%n%C %S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -52728,7 +52762,8 @@ var generated = data{
 		Name:            "CYPRUS",
 		DefaultLanguage: "el",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"2008", "3304", "1900"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52741,7 +52776,8 @@ var generated = data{
 		Name:            "CZECH REP.",
 		DefaultLanguage: "cs",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3} ?\d{2}`},
+			regex:    `\d{3} ?\d{2}`,
+			examples: []string{"100 00", "251 66", "530 87", "110 00", "225 99"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52758,7 +52794,8 @@ var generated = data{
 		Name:            "GERMANY",
 		DefaultLanguage: "de",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"26133", "53225"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52779,7 +52816,8 @@ var generated = data{
 		Name:            "DENMARK",
 		DefaultLanguage: "da",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"8660", "1566"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52800,7 +52838,8 @@ var generated = data{
 		Name:            "DOMINICAN REP.",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"11903", "11805"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52813,7 +52852,8 @@ var generated = data{
 		Name:            "ALGERIA",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"40304", "16027"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52826,7 +52866,8 @@ var generated = data{
 		Name:            "ECUADOR",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"090105", "092301"}},
 		Format: "%N%n%O%n%A%n%Z%n%C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52843,7 +52884,8 @@ var generated = data{
 		Name:            "ESTONIA",
 		DefaultLanguage: "et",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"69501", "11212"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -52860,7 +52902,8 @@ var generated = data{
 		Name:            "EGYPT",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"12411", "11599"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"ALX": {
 					regex: `^2[13]`}, "ASN": {
@@ -53180,7 +53223,8 @@ var generated = data{
 		Name:            "WESTERN SAHARA",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"70000", "72000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -53197,7 +53241,8 @@ var generated = data{
 		Name:            "SPAIN",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"28039", "28300", "28070"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"A": {
 					regex: `^03`}, "AB": {
@@ -54324,7 +54369,8 @@ var generated = data{
 		Name:            "ETHIOPIA",
 		DefaultLanguage: "am",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54337,7 +54383,8 @@ var generated = data{
 		Name:            "FINLAND",
 		DefaultLanguage: "fi",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"00550", "00011"}},
 		Format: "%O%n%N%n%A%nFI-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54358,7 +54405,8 @@ var generated = data{
 		Name:            "FALKLAND ISLANDS (MALVINAS)",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `FIQQ 1ZZ`},
+			regex:    `FIQQ 1ZZ`,
+			examples: []string{"FIQQ 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54379,7 +54427,8 @@ var generated = data{
 		Name:            "MICRONESIA (Federated State of)",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(9694[1-4])(?:[ \-](\d{4}))?`},
+			regex:    `(9694[1-4])(?:[ \-](\d{4}))?`,
+			examples: []string{"96941", "96944"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -54407,7 +54456,8 @@ var generated = data{
 		Name:            "FAROE ISLANDS",
 		DefaultLanguage: "fo",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}`},
+			regex:    `\d{3}`,
+			examples: []string{"100"}},
 		Format: "%N%n%O%n%A%nFO%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54420,7 +54470,8 @@ var generated = data{
 		Name:            "FRANCE",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{2} ?\d{3}`},
+			regex:    `\d{2} ?\d{3}`,
+			examples: []string{"33380", "34092", "33506"}},
 		Format: "%O%n%N%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54445,7 +54496,8 @@ var generated = data{
 		Name:            "UNITED KINGDOM",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `GIR ?0AA|(?:(?:AB|AL|B|BA|BB|BD|BF|BH|BL|BN|BR|BS|BT|BX|CA|CB|CF|CH|CM|CO|CR|CT|CV|CW|DA|DD|DE|DG|DH|DL|DN|DT|DY|E|EC|EH|EN|EX|FK|FY|G|GL|GY|GU|HA|HD|HG|HP|HR|HS|HU|HX|IG|IM|IP|IV|JE|KA|KT|KW|KY|L|LA|LD|LE|LL|LN|LS|LU|M|ME|MK|ML|N|NE|NG|NN|NP|NR|NW|OL|OX|PA|PE|PH|PL|PO|PR|RG|RH|RM|S|SA|SE|SG|SK|SL|SM|SN|SO|SP|SR|SS|ST|SW|SY|TA|TD|TF|TN|TQ|TR|TS|TW|UB|W|WA|WC|WD|WF|WN|WR|WS|WV|YO|ZE)(?:\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}))|BFPO ?\d{1,4}`},
+			regex:    `GIR ?0AA|(?:(?:AB|AL|B|BA|BB|BD|BF|BH|BL|BN|BR|BS|BT|BX|CA|CB|CF|CH|CM|CO|CR|CT|CV|CW|DA|DD|DE|DG|DH|DL|DN|DT|DY|E|EC|EH|EN|EX|FK|FY|G|GL|GY|GU|HA|HD|HG|HP|HR|HS|HU|HX|IG|IM|IP|IV|JE|KA|KT|KW|KY|L|LA|LD|LE|LL|LN|LS|LU|M|ME|MK|ML|N|NE|NG|NN|NP|NR|NW|OL|OX|PA|PE|PH|PL|PO|PR|RG|RH|RM|S|SA|SE|SG|SK|SL|SM|SN|SO|SP|SR|SS|ST|SW|SY|TA|TD|TF|TN|TQ|TR|TS|TW|UB|W|WA|WC|WD|WF|WN|WR|WS|WV|YO|ZE)(?:\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}))|BFPO ?\d{1,4}`,
+			examples: []string{"EC1Y 8SY", "GIR 0AA", "M2 5BQ", "M34 4AB", "CR0 2YR", "DN16 9AA", "W1A 4ZZ", "EC1A 1HQ", "OX14 4PG", "BS18 8HF", "NR25 7HG", "RH6 0NP", "BH23 6AA", "B6 5BA", "SO23 9AP", "PO1 3AX", "BFPO 61"}},
 		Format:           "%N%n%O%n%A%n%C%n%Z",
 		LocalityNameType: PostTown,
 		AllowedFields: map[Field]struct{}{
@@ -54471,7 +54523,8 @@ var generated = data{
 		Name:            "GEORGIA",
 		DefaultLanguage: "ka",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"2100", "0101"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54484,7 +54537,8 @@ var generated = data{
 		Name:            "FRENCH GUIANA",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78]3\d{2}`},
+			regex:    `9[78]3\d{2}`,
+			examples: []string{"97300"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54507,7 +54561,8 @@ var generated = data{
 		Name:            "CHANNEL ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `GY\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`},
+			regex:    `GY\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`,
+			examples: []string{"GY1 1AA", "GY2 2BT"}},
 		Format: "%N%n%O%n%A%n%C%nGUERNSEY%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54532,7 +54587,8 @@ var generated = data{
 		Name:            "GIBRALTAR",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `GX11 1AA`},
+			regex:    `GX11 1AA`,
+			examples: []string{"GX11 1AA"}},
 		Format: "%N%n%O%n%A%nGIBRALTAR%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Name:          {},
@@ -54546,7 +54602,8 @@ var generated = data{
 		Name:            "GREENLAND",
 		DefaultLanguage: "kl",
 		PostCodeRegex: postCodeRegex{
-			regex: `39\d{2}`},
+			regex:    `39\d{2}`,
+			examples: []string{"3900", "3950", "3911"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54567,7 +54624,8 @@ var generated = data{
 		Name:            "GUINEA",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}`},
+			regex:    `\d{3}`,
+			examples: []string{"001", "200", "100"}},
 		Format: "%N%n%O%n%Z %A %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54580,7 +54638,8 @@ var generated = data{
 		Name:            "GUADELOUPE",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78][01]\d{2}`},
+			regex:    `9[78][01]\d{2}`,
+			examples: []string{"97100"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54607,7 +54666,8 @@ var generated = data{
 		Name:            "GREECE",
 		DefaultLanguage: "el",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3} ?\d{2}`},
+			regex:    `\d{3} ?\d{2}`,
+			examples: []string{"151 24", "151 10", "101 88"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54624,7 +54684,8 @@ var generated = data{
 		Name:            "SOUTH GEORGIA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `SIQQ 1ZZ`},
+			regex:    `SIQQ 1ZZ`,
+			examples: []string{"SIQQ 1ZZ"}},
 		Format: "%N%n%O%n%A%n%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54645,7 +54706,8 @@ var generated = data{
 		Name:            "GUATEMALA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"09001", "01501"}},
 		Format: "%N%n%O%n%A%n%Z- %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54658,7 +54720,8 @@ var generated = data{
 		Name:            "GUAM",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(969(?:[12]\d|3[12]))(?:[ \-](\d{4}))?`},
+			regex:    `(969(?:[12]\d|3[12]))(?:[ \-](\d{4}))?`,
+			examples: []string{"96910", "96931"}},
 		Format:           "%N%n%O%n%A%n%C %Z",
 		PostCodeNameType: ZipCode,
 		AllowedFields: map[Field]struct{}{
@@ -54682,7 +54745,8 @@ var generated = data{
 		Name:            "GUINEA-BISSAU",
 		DefaultLanguage: "pt",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1000", "1011"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -54714,6 +54778,7 @@ var generated = data{
 		Upper: map[Field]struct{}{
 			AdministrativeArea: {},
 		},
+		NonISOSubdivisionIDs: true,
 		AdministrativeAreas: map[string]administrativeAreaSlice{
 			"en": {
 				{
@@ -55759,7 +55824,8 @@ var generated = data{
 		Name:            "HEARD AND MCDONALD ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"7050"}},
 		Format: "%O%n%N%n%A%n%C %S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -55777,7 +55843,8 @@ var generated = data{
 		Name:            "HONDURAS",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"31301"}},
 		Format: "%N%n%O%n%A%n%C, %S%n%Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -55795,7 +55862,8 @@ var generated = data{
 		Name:            "CROATIA",
 		DefaultLanguage: "hr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"10000", "21001", "10002"}},
 		Format: "%N%n%O%n%A%nHR-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -55808,7 +55876,8 @@ var generated = data{
 		Name:            "HAITI",
 		DefaultLanguage: "ht",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"6120", "5310", "6110", "8510"}},
 		Format: "%N%n%O%n%A%nHT%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -55821,7 +55890,8 @@ var generated = data{
 		Name:            "HUNGARY (Rep.)",
 		DefaultLanguage: "hu",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1037", "2380", "1540"}},
 		Format: "%N%n%O%n%C%n%A%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -55844,7 +55914,8 @@ var generated = data{
 		Name:            "INDONESIA",
 		DefaultLanguage: "id",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"40115"}},
 		Format: "%N%n%O%n%A%n%C%n%S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -56035,7 +56106,8 @@ var generated = data{
 		Name:            "IRELAND",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `[\dA-Z]{3} ?[\dA-Z]{4}`},
+			regex:    `[\dA-Z]{3} ?[\dA-Z]{4}`,
+			examples: []string{"A65 F4E2"}},
 		Format:                     "%N%n%O%n%A%n%D%n%C%n%S %Z",
 		AdministrativeAreaNameType: County,
 		DependentLocalityNameType:  Townland,
@@ -56187,7 +56259,8 @@ var generated = data{
 		Name:            "ISRAEL",
 		DefaultLanguage: "he",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}(?:\d{2})?`},
+			regex:    `\d{5}(?:\d{2})?`,
+			examples: []string{"9614303"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -56200,7 +56273,8 @@ var generated = data{
 		Name:            "ISLE OF MAN",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `IM\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`},
+			regex:    `IM\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`,
+			examples: []string{"IM2 1AA", "IM99 1PS"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -56221,7 +56295,8 @@ var generated = data{
 		Name:            "INDIA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`,
+			regex:    `\d{6}`,
+			examples: []string{"110034", "110001"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AN": {
 					regex: `^744`}, "AP": {
@@ -56646,7 +56721,8 @@ var generated = data{
 		Name:            "BRITISH INDIAN OCEAN TERRITORY",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `BBND 1ZZ`},
+			regex:    `BBND 1ZZ`,
+			examples: []string{"BBND 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -56667,7 +56743,8 @@ var generated = data{
 		Name:            "IRAQ",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"31001"}},
 		Format: "%O%n%N%n%A%n%C, %S%n%Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -56689,7 +56766,8 @@ var generated = data{
 		Name:            "IRAN",
 		DefaultLanguage: "fa",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}-?\d{5}`},
+			regex:    `\d{5}-?\d{5}`,
+			examples: []string{"11936-12345"}},
 		Format:                    "%O%n%N%n%S%n%C, %D%n%A%n%Z",
 		DependentLocalityNameType: Neighborhood,
 		AllowedFields: map[Field]struct{}{
@@ -57021,7 +57099,8 @@ var generated = data{
 		Name:            "ICELAND",
 		DefaultLanguage: "is",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}`},
+			regex:    `\d{3}`,
+			examples: []string{"320", "121", "220", "110"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -57034,7 +57113,8 @@ var generated = data{
 		Name:            "ITALY",
 		DefaultLanguage: "it",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"00144", "47037", "39049"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AG": {
 					regex: `^92`}, "AL": {
@@ -57723,7 +57803,8 @@ var generated = data{
 		Name:            "CHANNEL ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `JE\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`},
+			regex:    `JE\d[\dA-Z]? ?\d[ABD-HJLN-UW-Z]{2}`,
+			examples: []string{"JE1 1AA", "JE2 2BT"}},
 		Format: "%N%n%O%n%A%n%C%nJERSEY%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -57835,7 +57916,8 @@ var generated = data{
 		Name:            "JORDAN",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"11937", "11190"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -57848,7 +57930,8 @@ var generated = data{
 		Name:            "JAPAN",
 		DefaultLanguage: "ja",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}-?\d{4}`,
+			regex:    `\d{3}-?\d{4}`,
+			examples: []string{"154-0023", "350-1106", "951-8073", "112-0001", "208-0032", "231-0012"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"01": {
 					regex: `^0[4-9]|00[1-7]`}, "02": {
@@ -58395,7 +58478,8 @@ var generated = data{
 		Name:            "KENYA",
 		DefaultLanguage: "sw",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"20100", "00100"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -58408,7 +58492,8 @@ var generated = data{
 		Name:            "KYRGYZSTAN",
 		DefaultLanguage: "ky",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"720001"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -58421,7 +58506,8 @@ var generated = data{
 		Name:            "CAMBODIA",
 		DefaultLanguage: "km",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"12203", "14206", "12000"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -58620,7 +58706,8 @@ var generated = data{
 		Name:            "SOUTH KOREA",
 		DefaultLanguage: "ko",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"03051"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"11": {
 					regex: `^0[1-8]\d{2}`,
@@ -61532,7 +61619,8 @@ var generated = data{
 		Name:            "KUWAIT",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"54541", "54551", "54404", "13009"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61545,7 +61633,8 @@ var generated = data{
 		Name:            "CAYMAN ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `KY\d-\d{4}`},
+			regex:    `KY\d-\d{4}`,
+			examples: []string{"KY1-1100", "KY1-1702", "KY2-2101"}},
 		Format:                     "%N%n%O%n%A%n%S %Z",
 		AdministrativeAreaNameType: Island,
 		AllowedFields: map[Field]struct{}{
@@ -61557,6 +61646,7 @@ var generated = data{
 		RequiredFields: map[Field]struct{}{
 			AdministrativeArea: {},
 			StreetAddress:      {}},
+		NonISOSubdivisionIDs: true,
 		AdministrativeAreas: map[string]administrativeAreaSlice{
 			"en": {
 				{
@@ -61581,7 +61671,8 @@ var generated = data{
 		Name:            "KAZAKHSTAN",
 		DefaultLanguage: "ru",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"040900", "050012"}},
 		Format: "%Z%n%S%n%C%n%A%n%O%n%N",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -61595,7 +61686,8 @@ var generated = data{
 		Name:            "LAO (PEOPLE'S DEM. REP.)",
 		DefaultLanguage: "lo",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"01160", "01000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61608,7 +61700,8 @@ var generated = data{
 		Name:            "LEBANON",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:\d{4})(?: ?(?:\d{4}))?`},
+			regex:    `(?:\d{4})(?: ?(?:\d{4}))?`,
+			examples: []string{"2038 3054", "1107 2810", "1000"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61625,7 +61718,8 @@ var generated = data{
 		Name:            "LIECHTENSTEIN",
 		DefaultLanguage: "de",
 		PostCodeRegex: postCodeRegex{
-			regex: `948[5-9]|949[0-8]`},
+			regex:    `948[5-9]|949[0-8]`,
+			examples: []string{"9496", "9491", "9490", "9485"}},
 		Format: "%O%n%N%n%A%nFL-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61642,7 +61736,8 @@ var generated = data{
 		Name:            "SRI LANKA",
 		DefaultLanguage: "si",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"20000", "00100"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61655,7 +61750,8 @@ var generated = data{
 		Name:            "LIBERIA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61668,7 +61764,8 @@ var generated = data{
 		Name:            "LESOTHO",
 		DefaultLanguage: "st",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}`},
+			regex:    `\d{3}`,
+			examples: []string{"100"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61681,7 +61778,8 @@ var generated = data{
 		Name:            "LITHUANIA",
 		DefaultLanguage: "lt",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"04340", "03500"}},
 		Format: "%O%n%N%n%A%nLT-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61698,7 +61796,8 @@ var generated = data{
 		Name:            "LUXEMBOURG",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"4750", "2998"}},
 		Format: "%O%n%N%n%A%nL-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61715,7 +61814,8 @@ var generated = data{
 		Name:            "LATVIA",
 		DefaultLanguage: "lv",
 		PostCodeRegex: postCodeRegex{
-			regex: `LV-\d{4}`},
+			regex:    `LV-\d{4}`,
+			examples: []string{"LV-1073", "LV-1000"}},
 		Format: "%N%n%O%n%A%n%C, %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61736,7 +61836,8 @@ var generated = data{
 		Name:            "MOROCCO",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"53000", "10000", "20050", "16052"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61749,7 +61850,8 @@ var generated = data{
 		Name:            "MONACO",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `980\d{2}`},
+			regex:    `980\d{2}`,
+			examples: []string{"98000", "98020", "98011", "98001"}},
 		Format: "%N%n%O%n%A%nMC-%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61763,7 +61865,8 @@ var generated = data{
 		Name:            "Rep. MOLDOVA",
 		DefaultLanguage: "ro",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"2012", "2019"}},
 		Format: "%N%n%O%n%A%nMD-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61776,7 +61879,8 @@ var generated = data{
 		Name:            "MONTENEGRO",
 		DefaultLanguage: "sr",
 		PostCodeRegex: postCodeRegex{
-			regex: `8\d{4}`},
+			regex:    `8\d{4}`,
+			examples: []string{"81257", "81258", "81217", "84314", "85366"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61789,7 +61893,8 @@ var generated = data{
 		Name:            "SAINT MARTIN",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78][01]\d{2}`},
+			regex:    `9[78][01]\d{2}`,
+			examples: []string{"97100"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61812,7 +61917,8 @@ var generated = data{
 		Name:            "MADAGASCAR",
 		DefaultLanguage: "mg",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}`},
+			regex:    `\d{3}`,
+			examples: []string{"501", "101"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61825,7 +61931,8 @@ var generated = data{
 		Name:            "MARSHALL ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(969[67]\d)(?:[ \-](\d{4}))?`},
+			regex:    `(969[67]\d)(?:[ \-](\d{4}))?`,
+			examples: []string{"96960", "96970"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -61853,7 +61960,8 @@ var generated = data{
 		Name:            "MACEDONIA",
 		DefaultLanguage: "mk",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1314", "1321", "1443", "1062"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61870,7 +61978,8 @@ var generated = data{
 		Name:            "MYANMAR",
 		DefaultLanguage: "my",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"11181"}},
 		Format: "%N%n%O%n%A%n%C, %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61883,7 +61992,8 @@ var generated = data{
 		Name:            "MONGOLIA",
 		DefaultLanguage: "mn",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"65030", "65270"}},
 		Format: "%N%n%O%n%A%n%C%n%S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -61909,7 +62019,8 @@ var generated = data{
 		Name:            "NORTHERN MARIANA ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(9695[012])(?:[ \-](\d{4}))?`},
+			regex:    `(9695[012])(?:[ \-](\d{4}))?`,
+			examples: []string{"96950", "96951", "96952"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -61937,7 +62048,8 @@ var generated = data{
 		Name:            "MARTINIQUE",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78]2\d{2}`},
+			regex:    `9[78]2\d{2}`,
+			examples: []string{"97220"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61972,7 +62084,8 @@ var generated = data{
 		Name:            "MALTA",
 		DefaultLanguage: "mt",
 		PostCodeRegex: postCodeRegex{
-			regex: `[A-Z]{3} ?\d{2,4}`},
+			regex:    `[A-Z]{3} ?\d{2,4}`,
+			examples: []string{"NXR 01", "ZTN 05", "GPO 01", "BZN 1130", "SPB 6031", "VCT 1753"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -61989,7 +62102,8 @@ var generated = data{
 		Name:            "MAURITIUS",
 		DefaultLanguage: "mfe",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}(?:\d{2}|[A-Z]{2}\d{3})`},
+			regex:    `\d{3}(?:\d{2}|[A-Z]{2}\d{3})`,
+			examples: []string{"42602"}},
 		Format: "%N%n%O%n%A%n%Z%n%C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62006,7 +62120,8 @@ var generated = data{
 		Name:            "MALDIVES",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"20026"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62030,7 +62145,8 @@ var generated = data{
 		Name:            "MEXICO",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"02860", "77520", "06082"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AGU": {
 					regex: `^20`}, "BCN": {
@@ -62254,7 +62370,8 @@ var generated = data{
 		Name:            "MALAYSIA",
 		DefaultLanguage: "ms",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"43000", "50754", "88990", "50670"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"01": {
 					regex: `^79|8[0-6]`}, "02": {
@@ -62381,7 +62498,8 @@ var generated = data{
 		Name:            "MOZAMBIQUE",
 		DefaultLanguage: "pt",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1102", "1119", "3212"}},
 		Format: "%N%n%O%n%A%n%Z %C%S",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -62454,7 +62572,8 @@ var generated = data{
 		Name:            "NAMIBIA",
 		DefaultLanguage: "af",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"10001", "10017"}},
 		Format: "%N%n%O%n%A%n%Cn%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62467,7 +62586,8 @@ var generated = data{
 		Name:            "NEW CALEDONIA",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `988\d{2}`},
+			regex:    `988\d{2}`,
+			examples: []string{"98814", "98800", "98810"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62490,7 +62610,8 @@ var generated = data{
 		Name:            "NIGER",
 		DefaultLanguage: "ha",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"8001"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62503,7 +62624,8 @@ var generated = data{
 		Name:            "NORFOLK ISLAND",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `2899`},
+			regex:    `2899`,
+			examples: []string{"2899"}},
 		Format: "%O%n%N%n%A%n%C %S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -62521,7 +62643,8 @@ var generated = data{
 		Name:            "NIGERIA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"930283", "300001", "931104"}},
 		Format:                     "%N%n%O%n%A%n%D%n%C %Z%n%S",
 		AdministrativeAreaNameType: State,
 		AllowedFields: map[Field]struct{}{
@@ -62730,7 +62853,8 @@ var generated = data{
 		Name:            "NICARAGUA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"52000"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AN": {
 					regex: `^7[12]`}, "AS": {
@@ -62857,7 +62981,8 @@ var generated = data{
 		Name:            "NETHERLANDS",
 		DefaultLanguage: "nl",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4} ?[A-Z]{2}`},
+			regex:    `\d{4} ?[A-Z]{2}`,
+			examples: []string{"1234 AB", "2490 AA"}},
 		Format: "%O%n%N%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62874,7 +62999,8 @@ var generated = data{
 		Name:            "NORWAY",
 		DefaultLanguage: "nb",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"0025", "0107", "6631"}},
 		Format:           "%N%n%O%n%A%n%Z %C",
 		LocalityNameType: PostTown,
 		AllowedFields: map[Field]struct{}{
@@ -62892,7 +63018,8 @@ var generated = data{
 		Name:            "NEPAL",
 		DefaultLanguage: "ne",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"44601"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -62997,7 +63124,8 @@ var generated = data{
 		Name:            "NEW ZEALAND",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"6001", "6015", "6332", "8252", "1030"}},
 		Format: "%N%n%O%n%A%n%D%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			DependentLocality: {},
@@ -63015,7 +63143,8 @@ var generated = data{
 		Name:            "OMAN",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:PC )?\d{3}`},
+			regex:    `(?:PC )?\d{3}`,
+			examples: []string{"133", "112", "111"}},
 		Format: "%N%n%O%n%A%n%Z%n%C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63043,7 +63172,8 @@ var generated = data{
 		Name:            "PERU",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:LIMA \d{1,2}|CALLAO 0?\d)|[0-2]\d{4}`},
+			regex:    `(?:LIMA \d{1,2}|CALLAO 0?\d)|[0-2]\d{4}`,
+			examples: []string{"LIMA 23", "LIMA 42", "CALLAO 2", "02001"}},
 		Format:           "%N%n%O%n%A%n%C %Z%n%S",
 		LocalityNameType: District,
 		AllowedFields: map[Field]struct{}{
@@ -63192,7 +63322,8 @@ var generated = data{
 		Name:            "FRENCH POLYNESIA",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `987\d{2}`},
+			regex:    `987\d{2}`,
+			examples: []string{"98709"}},
 		Format:                     "%N%n%O%n%A%n%Z %C %S",
 		AdministrativeAreaNameType: Island,
 		AllowedFields: map[Field]struct{}{
@@ -63216,7 +63347,8 @@ var generated = data{
 		Name:            "PAPUA NEW GUINEA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}`},
+			regex:    `\d{3}`,
+			examples: []string{"111"}},
 		Format: "%N%n%O%n%A%n%C %Z %S",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -63234,7 +63366,8 @@ var generated = data{
 		Name:            "PHILIPPINES",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`,
+			regex:    `\d{4}`,
+			examples: []string{"1008", "1050", "1135", "1207", "2000", "1000"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"ABR": {
 					regex: `^28[0-2]`}, "AGN": {
@@ -63746,7 +63879,8 @@ var generated = data{
 		Name:            "PAKISTAN",
 		DefaultLanguage: "ur",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"44000"}},
 		Format: "%N%n%O%n%A%n%C-%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63759,7 +63893,8 @@ var generated = data{
 		Name:            "POLAND",
 		DefaultLanguage: "pl",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{2}-\d{3}`},
+			regex:    `\d{2}-\d{3}`,
+			examples: []string{"00-950", "05-470", "48-300", "32-015", "00-940"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63776,7 +63911,8 @@ var generated = data{
 		Name:            "ST. PIERRE AND MIQUELON",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78]5\d{2}`},
+			regex:    `9[78]5\d{2}`,
+			examples: []string{"97500"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63799,7 +63935,8 @@ var generated = data{
 		Name:            "PITCAIRN",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `PCRN 1ZZ`},
+			regex:    `PCRN 1ZZ`,
+			examples: []string{"PCRN 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63821,7 +63958,8 @@ var generated = data{
 		DefaultLanguage: "es",
 		PostCodePrefix:  "PR ",
 		PostCodeRegex: postCodeRegex{
-			regex: `(00[679]\d{2})(?:[ \-](\d{4}))?`},
+			regex:    `(00[679]\d{2})(?:[ \-](\d{4}))?`,
+			examples: []string{"00930"}},
 		Format:           "%N%n%O%n%A%n%C PR %Z",
 		PostCodeNameType: ZipCode,
 		AllowedFields: map[Field]struct{}{
@@ -63849,7 +63987,8 @@ var generated = data{
 		Name:            "PORTUGAL",
 		DefaultLanguage: "pt",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}-\d{3}`},
+			regex:    `\d{4}-\d{3}`,
+			examples: []string{"2725-079", "1250-096", "1201-950", "2860-571", "1208-148"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63866,7 +64005,8 @@ var generated = data{
 		Name:            "PALAU",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(969(?:39|40))(?:[ \-](\d{4}))?`},
+			regex:    `(969(?:39|40))(?:[ \-](\d{4}))?`,
+			examples: []string{"96940"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -63894,7 +64034,8 @@ var generated = data{
 		Name:            "PARAGUAY",
 		DefaultLanguage: "gn",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1536", "1538", "1209"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63915,7 +64056,8 @@ var generated = data{
 		Name:            "REUNION",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `9[78]4\d{2}`},
+			regex:    `9[78]4\d{2}`,
+			examples: []string{"97400"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63938,7 +64080,8 @@ var generated = data{
 		Name:            "ROMANIA",
 		DefaultLanguage: "ro",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"060274", "061357", "200716"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63959,7 +64102,8 @@ var generated = data{
 		Name:            "REPUBLIC OF SERBIA",
 		DefaultLanguage: "sr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5,6}`},
+			regex:    `\d{5,6}`,
+			examples: []string{"106314"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -63972,7 +64116,8 @@ var generated = data{
 		Name:            "RUSSIAN FEDERATION",
 		DefaultLanguage: "ru",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`,
+			regex:    `\d{6}`,
+			examples: []string{"125075"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AD": {
 					regex: `^385`}, "AL": {
@@ -64926,7 +65071,8 @@ var generated = data{
 		Name:            "SAUDI ARABIA",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"11564", "11187", "11142"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -64958,7 +65104,8 @@ var generated = data{
 		Name:            "SUDAN",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"11042", "11113"}},
 		Format:           "%N%n%O%n%A%n%C%n%Z",
 		LocalityNameType: District,
 		AllowedFields: map[Field]struct{}{
@@ -64972,7 +65119,8 @@ var generated = data{
 		Name:            "SWEDEN",
 		DefaultLanguage: "sv",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3} ?\d{2}`},
+			regex:    `\d{3} ?\d{2}`,
+			examples: []string{"11455", "12345", "10500"}},
 		Format:           "%O%n%N%n%A%nSE-%Z %C",
 		LocalityNameType: PostTown,
 		AllowedFields: map[Field]struct{}{
@@ -64990,7 +65138,8 @@ var generated = data{
 		Name:            "REP. OF SINGAPORE",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"546080", "308125", "408600"}},
 		Format: "%N%n%O%n%A%nSINGAPORE %Z",
 		AllowedFields: map[Field]struct{}{
 			Name:          {},
@@ -65005,7 +65154,8 @@ var generated = data{
 		Name:            "SAINT HELENA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(?:ASCN|STHL) 1ZZ`},
+			regex:    `(?:ASCN|STHL) 1ZZ`,
+			examples: []string{"STHL 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65026,7 +65176,8 @@ var generated = data{
 		Name:            "SLOVENIA",
 		DefaultLanguage: "sl",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"4000", "1001", "2500"}},
 		Format: "%N%n%O%n%A%nSI-%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65039,7 +65190,8 @@ var generated = data{
 		Name:            "SVALBARD AND JAN MAYEN ISLANDS",
 		DefaultLanguage: "nb",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"9170"}},
 		Format:           "%N%n%O%n%A%n%Z %C",
 		LocalityNameType: PostTown,
 		AllowedFields: map[Field]struct{}{
@@ -65057,7 +65209,8 @@ var generated = data{
 		Name:            "SLOVAKIA",
 		DefaultLanguage: "sk",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3} ?\d{2}`},
+			regex:    `\d{3} ?\d{2}`,
+			examples: []string{"010 01", "023 14"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65078,7 +65231,8 @@ var generated = data{
 		Name:            "SAN MARINO",
 		DefaultLanguage: "it",
 		PostCodeRegex: postCodeRegex{
-			regex: `4789\d`},
+			regex:    `4789\d`,
+			examples: []string{"47890", "47891", "47895", "47899"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65094,7 +65248,8 @@ var generated = data{
 		Name:            "SENEGAL",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"12500", "46024", "16556", "10000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65107,7 +65262,8 @@ var generated = data{
 		Name:            "SOMALIA",
 		DefaultLanguage: "so",
 		PostCodeRegex: postCodeRegex{
-			regex: `[A-Z]{2} ?\d{5}`},
+			regex:    `[A-Z]{2} ?\d{5}`,
+			examples: []string{"JH 09010", "AD 11010"}},
 		Format: "%N%n%O%n%A%n%C, %S %Z",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -65301,7 +65457,8 @@ var generated = data{
 		Name:            "EL SALVADOR",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `CP [1-3][1-7][0-2]\d`,
+			regex:    `CP [1-3][1-7][0-2]\d`,
+			examples: []string{"CP 1101"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AH": {
 					regex: `^CP 21`}, "CA": {
@@ -65423,7 +65580,8 @@ var generated = data{
 		Name:            "SWAZILAND",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `[HLMS]\d{3}`},
+			regex:    `[HLMS]\d{3}`,
+			examples: []string{"H100"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65441,7 +65599,8 @@ var generated = data{
 		Name:            "TRISTAN DA CUNHA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `TDCU 1ZZ`},
+			regex:    `TDCU 1ZZ`,
+			examples: []string{"TDCU 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65454,7 +65613,8 @@ var generated = data{
 		Name:            "TURKS AND CAICOS ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `TKCA 1ZZ`},
+			regex:    `TKCA 1ZZ`,
+			examples: []string{"TKCA 1ZZ"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -65487,7 +65647,8 @@ var generated = data{
 		Name:            "THAILAND",
 		DefaultLanguage: "th",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"10150", "10210"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"10": {
 					regex: `^10`}, "11": {
@@ -66360,7 +66521,8 @@ var generated = data{
 		Name:            "TAJIKISTAN",
 		DefaultLanguage: "tg",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"735450", "734025"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -66381,7 +66543,8 @@ var generated = data{
 		Name:            "TURKMENISTAN",
 		DefaultLanguage: "tk",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"744000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -66394,7 +66557,8 @@ var generated = data{
 		Name:            "TUNISIA",
 		DefaultLanguage: "ar",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1002", "8129", "3100", "1030"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -66411,7 +66575,8 @@ var generated = data{
 		Name:            "TURKEY",
 		DefaultLanguage: "tr",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"01960", "06101"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"01": {
 					regex: `^01`}, "02": {
@@ -66987,7 +67152,8 @@ var generated = data{
 		Name:            "TAIWAN",
 		DefaultLanguage: "zh-Hant",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{3}(?:\d{2})?`,
+			regex:    `\d{3}(?:\d{2})?`,
+			examples: []string{"104", "106", "10603", "40867"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"CHA": {
 					regex: `^5[0123]`,
@@ -70587,7 +70753,8 @@ var generated = data{
 		Name:            "TANZANIA (UNITED REP.)",
 		DefaultLanguage: "sw",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4,5}`},
+			regex:    `\d{4,5}`,
+			examples: []string{"6090", "34413"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -70600,7 +70767,8 @@ var generated = data{
 		Name:            "UKRAINE",
 		DefaultLanguage: "uk",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"15432", "01055", "01001"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"05": {
 					regex: `^2[1-4]`}, "07": {
@@ -70930,7 +71098,8 @@ var generated = data{
 		Name:            "UNITED STATES MINOR OUTLYING ISLANDS",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `96898`},
+			regex:    `96898`,
+			examples: []string{"96898"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -70957,7 +71126,8 @@ var generated = data{
 		Name:            "UNITED STATES",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(\d{5})(?:[ \-](\d{4}))?`,
+			regex:    `(\d{5})(?:[ \-](\d{4}))?`,
+			examples: []string{"95014", "22162-1010"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AK": {
 					regex: `^99[5-9]`}, "AL": {
@@ -71294,7 +71464,8 @@ var generated = data{
 		Name:            "URUGUAY",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`,
+			regex:    `\d{5}`,
+			examples: []string{"11600"},
 			subdivisionRegex: map[string]postCodeRegex{
 				"AR": {
 					regex: `^55`}, "CA": {
@@ -71432,7 +71603,8 @@ var generated = data{
 		Name:            "UZBEKISTAN",
 		DefaultLanguage: "uz",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{6}`},
+			regex:    `\d{6}`,
+			examples: []string{"702100", "700000"}},
 		Format: "%N%n%O%n%A%n%Z %C%n%S",
 		AllowedFields: map[Field]struct{}{
 			AdministrativeArea: {},
@@ -71450,7 +71622,8 @@ var generated = data{
 		Name:            "VATICAN",
 		DefaultLanguage: "it",
 		PostCodeRegex: postCodeRegex{
-			regex: `00120`},
+			regex:    `00120`,
+			examples: []string{"00120"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -71463,7 +71636,8 @@ var generated = data{
 		Name:            "SAINT VINCENT AND THE GRENADINES (ANTILLES)",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `VC\d{4}`},
+			regex:    `VC\d{4}`,
+			examples: []string{"VC0100", "VC0110", "VC0400"}},
 		Format: "%N%n%O%n%A%n%C %Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -71476,7 +71650,8 @@ var generated = data{
 		Name:            "VENEZUELA",
 		DefaultLanguage: "es",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"1010", "3001", "8011", "1020"}},
 		Format:                     "%N%n%O%n%A%n%C %Z, %S",
 		AdministrativeAreaNameType: State,
 		AllowedFields: map[Field]struct{}{
@@ -71628,7 +71803,8 @@ var generated = data{
 		Name:            "VIRGIN ISLANDS (BRITISH)",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `VG\d{4}`},
+			regex:    `VG\d{4}`,
+			examples: []string{"VG1110", "VG1150", "VG1160"}},
 		Format: "%N%n%O%n%A%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -71643,7 +71819,8 @@ var generated = data{
 		Name:            "VIRGIN ISLANDS (U.S.)",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `(008(?:(?:[0-4]\d)|(?:5[01])))(?:[ \-](\d{4}))?`},
+			regex:    `(008(?:(?:[0-4]\d)|(?:5[01])))(?:[ \-](\d{4}))?`,
+			examples: []string{"00802-1222", "00850-9802"}},
 		Format:                     "%N%n%O%n%A%n%C %S %Z",
 		AdministrativeAreaNameType: State,
 		PostCodeNameType:           ZipCode,
@@ -71671,7 +71848,8 @@ var generated = data{
 		Name:            "VIET NAM",
 		DefaultLanguage: "vi",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}\d?`},
+			regex:    `\d{5}\d?`,
+			examples: []string{"70010", "55999"}},
 		Format:          "%N%n%O%n%A%n%C%n%S %Z",
 		LatinizedFormat: "%N%n%O%n%A%n%C%n%S %Z",
 		AllowedFields: map[Field]struct{}{
@@ -72326,7 +72504,8 @@ var generated = data{
 		Name:            "WALLIS AND FUTUNA ISLANDS",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `986\d{2}`},
+			regex:    `986\d{2}`,
+			examples: []string{"98600"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -72353,7 +72532,8 @@ var generated = data{
 		Name:            "KOSOVO",
 		DefaultLanguage: "sq",
 		PostCodeRegex: postCodeRegex{
-			regex: `[1-7]\d{4}`},
+			regex:    `[1-7]\d{4}`,
+			examples: []string{"10000"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -72370,7 +72550,8 @@ var generated = data{
 		Name:            "MAYOTTE",
 		DefaultLanguage: "fr",
 		PostCodeRegex: postCodeRegex{
-			regex: `976\d{2}`},
+			regex:    `976\d{2}`,
+			examples: []string{"97600"}},
 		Format: "%O%n%N%n%A%n%Z %C %X",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -72393,7 +72574,8 @@ var generated = data{
 		Name:            "SOUTH AFRICA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{4}`},
+			regex:    `\d{4}`,
+			examples: []string{"0083", "1451", "0001"}},
 		Format: "%N%n%O%n%A%n%D%n%C%n%Z",
 		AllowedFields: map[Field]struct{}{
 			DependentLocality: {},
@@ -72411,7 +72593,8 @@ var generated = data{
 		Name:            "ZAMBIA",
 		DefaultLanguage: "en",
 		PostCodeRegex: postCodeRegex{
-			regex: `\d{5}`},
+			regex:    `\d{5}`,
+			examples: []string{"50100", "50101"}},
 		Format: "%N%n%O%n%A%n%Z %C",
 		AllowedFields: map[Field]struct{}{
 			Locality:      {},
@@ -46,9 +46,11 @@ type AdministrativeAreaSlice []AdministrativeAreaData
 // If the country has subdivisions
 // (administrative areas, localities and dependent localities),
 // the SubdivisionRegex field may contain further regular
-// expressions to Validate the post code.
+// expressions to Validate the post code. The Examples
+// field contains example post codes, if there are any.
 type PostCodeRegexData struct {
 	Regex            string
+	Examples         []string
 	SubdivisionRegex map[string]PostCodeRegexData
 }
 
@@ -57,7 +59,9 @@ type PostCodeRegexData struct {
 // subdivisions (administrative areas, localities and dependent
 // localities) grouped by their translated languages. They
 // are also sorted according to the sort order of the languages
-// they are in.
+// they are in. The PostCodeMask and PostCodeHint fields describe
+// the format of post codes for input forms (see PostCodeMask and
+// PostCodeHint).
 type CountryData struct {
 	Format                     string                             `json:"format"`
 	LatinizedFormat            string                             `json:"latinized_format"`
@@ -69,15 +73,21 @@ type CountryData struct {
 	DependentLocalityNameType  FieldName                          `json:"dependent_locality_name_type"`
 	PostCodeNameType           FieldName                          `json:"post_code_name_type"`
 	PostCodeRegex              PostCodeRegexData                  `json:"post_code_regex"`
+	PostCodeMask               string                             `json:"post_code_mask"`
+	PostCodeHint               string                             `json:"post_code_hint"`
 	AdministrativeAreas        map[string]AdministrativeAreaSlice `json:"administrative_areas"`
 }
 
 // CountryListItem represents a single country
 // containing the ISO 3166-1 code and the name
-// of the country.
+// of the country. Code is the alpha-2 code used
+// by addresses. Alpha3 and Numeric are empty if
+// the country does not have those codes.
 type CountryListItem struct {
-	Code string `json:"code"`
-	Name string `json:"name"`
+	Code    string `json:"code"`
+	Alpha3  string `json:"alpha3"`
+	Numeric string `json:"numeric"`
+	Name    string `json:"name"`
 }
 
 // CountryList contains a list of countries that can be used
@@ -127,9 +137,12 @@ func ListCountries(l string) CountryList {
 			continue
 		}
 		cc := language.MustParseRegion(country)
+		alpha3, numeric := isoCountryCodes(country)
 		list = append(list, CountryListItem{
-			Code: country,
-			Name: n.Name(cc),
+			Code:    country,
+			Alpha3:  alpha3,
+			Numeric: numeric,
+			Name:    n.Name(cc),
 		})
 	}
 
@@ -139,14 +152,14 @@ func ListCountries(l string) CountryList {
 
 // Get country returns address information for a given country.
 func GetCountry(cc string) CountryData {
-	country := generated.getCountry(cc)
+	country := generated.getCountry(countryCode(cc))
 	return internalToExternalCountry(country)
 }
 
 // Get external country returns readadble address information
 // for a given country.
 func GetExternalCountry(cc string) ExternalCountry {
-	country := generated.getCountry(cc)
+	country := generated.getCountry(countryCode(cc))
 	return Externalize(country)
 }
 
@@ -160,6 +173,8 @@ func internalToExternalCountry(c country) CountryData {
 		DependentLocalityNameType:  c.DependentLocalityNameType,
 		PostCodeNameType:           c.PostCodeNameType,
 		PostCodeRegex:              internalToExternalPostCodeRegex(c.PostCodeRegex),
+		PostCodeMask:               postCodeMask(c),
+		PostCodeHint:               postCodeHint(c),
 	}
 
 	var required []Field
@@ -204,6 +219,10 @@ func internalToExternalPostCodeRegex(regex postCodeRegex) PostCodeRegexData {
 		Regex: regex.regex,
 	}
 
+	if len(regex.examples) > 0 {
+		result.Examples = append([]string(nil), regex.examples...)
+	}
+
 	for subID, regex := range regex.subdivisionRegex {
 		if result.SubdivisionRegex == nil {
 			result.SubdivisionRegex = map[string]PostCodeRegexData{}
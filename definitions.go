@@ -18,11 +18,14 @@ type country struct {
 	RequiredFields map[Field]struct{}
 	Upper          map[Field]struct{}
 
+	NonISOSubdivisionIDs bool
+
 	AdministrativeAreas map[string]administrativeAreaSlice
 }
 
 type postCodeRegex struct {
 	regex            string
+	examples         []string
 	subdivisionRegex map[string]postCodeRegex
 }
 
@@ -98,71 +101,47 @@ func (d data) hasCountry(cc string) bool {
 }
 
 func (d data) getAdministrativeAreaName(cc, areaID, language string) string {
-	data := d.getCountry(cc)
 	lang := d.normalizeLanguage(cc, language)
 
-	for _, area := range data.AdministrativeAreas[lang] {
-		if area.ID == areaID {
-			return area.Name
-		}
+	if area, ok := d.subdivisionIndex(cc).administrativeArea(lang, areaID); ok {
+		return area.area.Name
 	}
 
 	return ""
 }
 
 func (d data) getAdministrativeAreaPostalKey(cc, areaID string) string {
-	data := d.getCountry(cc)
 	lang := d.normalizeLanguage(cc, "")
 
-	for _, area := range data.AdministrativeAreas[lang] {
-		if area.ID == areaID {
-			return area.PostalKey
-		}
+	if area, ok := d.subdivisionIndex(cc).administrativeArea(lang, areaID); ok {
+		return area.area.PostalKey
 	}
 
 	return ""
 }
 
 func (d data) getLocalityName(cc, areaID, localityID, language string) string {
-	data := d.getCountry(cc)
 	lang := d.normalizeLanguage(cc, language)
 
-	for _, area := range data.AdministrativeAreas[lang] {
-		if area.ID == areaID {
-			for _, l := range area.Localities {
-				if l.ID == localityID {
-					return l.Name
-				}
-			}
-		}
+	if l, ok := d.subdivisionIndex(cc).locality(lang, areaID, localityID); ok {
+		return l.locality.Name
 	}
 
 	return ""
 }
 
 func (d data) getDependentLocalityName(cc, areaID, lID, dlID, language string) string {
-	data := d.getCountry(cc)
 	lang := d.normalizeLanguage(cc, language)
 
-	for _, area := range data.AdministrativeAreas[lang] {
-		if area.ID == areaID {
-			for _, l := range area.Localities {
-				if l.ID == lID {
-					for _, d := range l.DependentLocalities {
-						if d.ID == dlID {
-							return d.Name
-						}
-					}
-				}
-			}
-		}
+	if dl, ok := d.subdivisionIndex(cc).dependentLocality(lang, areaID, lID, dlID); ok {
+		return dl.Name
 	}
 
 	return ""
 }
 
 func (d data) normalizeLanguage(cc, language string) string {
-	country := d.getCountry(cc)
+	country := d[cc]
 	if _, ok := country.AdministrativeAreas[language]; ok {
 		return language
 	}
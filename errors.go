@@ -11,6 +11,10 @@ var (
 	// used to create an address is invalid
 	ErrInvalidCountryCode = errors.New("invalid:Country")
 
+	// ErrUnsupportedCountry indicates that the country code is
+	// valid, but the country is not allowed by the Validator.
+	ErrUnsupportedCountry = errors.New("unsupported:Country")
+
 	// ErrInvalidDependentLocality indicates that the dependent
 	// locality is invalid. This is usually due to the country
 	// having a pre-determined list of dependent localities and
@@ -34,8 +38,48 @@ var (
 	// ErrInvalidPostCode indicates that the post code did not validate
 	// using the regular expression of the country
 	ErrInvalidPostCode = errors.New("invalid:PostCode")
+
+	// ErrPostCodeAdministrativeAreaMismatch indicates that the post
+	// code is valid for the country, but does not belong to the
+	// administrative area. It wraps ErrInvalidPostCode.
+	ErrPostCodeAdministrativeAreaMismatch error = postCodeMismatchError{AdministrativeArea}
+
+	// ErrPostCodeLocalityMismatch indicates that the post code is
+	// valid for the administrative area, but does not belong to the
+	// locality. It wraps ErrInvalidPostCode.
+	ErrPostCodeLocalityMismatch error = postCodeMismatchError{Locality}
+
+	// ErrPostCodeDependentLocalityMismatch indicates that the post
+	// code is valid for the locality, but does not belong to the
+	// dependent locality. It wraps ErrInvalidPostCode.
+	ErrPostCodeDependentLocalityMismatch error = postCodeMismatchError{DependentLocality}
+
+	// ErrNoISOSubdivisionCode indicates that the administrative area
+	// exists, but does not have an ISO 3166-2 code, because the IDs
+	// of the administrative areas of the country are not ISO codes.
+	ErrNoISOSubdivisionCode = errors.New("unsupported:ISOSubdivisionCode")
+
+	// ErrInvalidISOSubdivisionCode indicates that an ISO 3166-2 code
+	// is malformed, or does not match any administrative area.
+	ErrInvalidISOSubdivisionCode = errors.New("invalid:ISOSubdivisionCode")
+
+	// ErrNoISOCountryCode indicates that the country exists, but does
+	// not have an ISO 3166-1 alpha-3 or numeric code.
+	ErrNoISOCountryCode = errors.New("unsupported:ISOCountryCode")
 )
 
+type postCodeMismatchError struct {
+	level Field
+}
+
+func (e postCodeMismatchError) Error() string {
+	return fmt.Sprintf("mismatch:PostCode:%s", e.level)
+}
+
+func (e postCodeMismatchError) Unwrap() error {
+	return ErrInvalidPostCode
+}
+
 // ErrMissingRequiredFields indicates that a required address
 // field is missing. The Fields field can be used can be used
 // to get a list of missing field.
@@ -75,3 +119,169 @@ func (e ErrUnsupportedFields) Error() string {
 		strings.Join(fieldStr, ","),
 	)
 }
+
+// ErrAmbiguousSubdivision indicates that the name of an administrative
+// area, locality or dependent locality matches more than one subdivision.
+// The Candidates field contains the IDs of the matching subdivisions.
+type ErrAmbiguousSubdivision struct {
+	Field      Field
+	Value      string
+	Candidates []string
+}
+
+func (e ErrAmbiguousSubdivision) Error() string {
+	return fmt.Sprintf(
+		"ambiguous:%s:%s matches %s",
+		e.Field,
+		e.Value,
+		strings.Join(e.Candidates, ","),
+	)
+}
+
+// ErrorCode is a machine readable code describing
+// why a field of an address failed validation.
+type ErrorCode string
+
+const (
+	// CodeMissing indicates that a required field is empty.
+	CodeMissing ErrorCode = "missing"
+
+	// CodeUnsupported indicates that a field is provided, but it
+	// is not supported by the address format of the country.
+	CodeUnsupported ErrorCode = "unsupported"
+
+	// CodeInvalidValue indicates that the value of a field is not
+	// valid, such as a subdivision that does not exist or a post
+	// code that does not match the format of the country.
+	CodeInvalidValue ErrorCode = "invalid_value"
+
+	// CodeMismatch indicates that the post code is valid for the
+	// country, but does not belong to the subdivision given by Level.
+	CodeMismatch ErrorCode = "mismatch"
+
+	// CodeAmbiguous indicates that the name of a subdivision matches
+	// more than one subdivision when the address is normalized.
+	CodeAmbiguous ErrorCode = "ambiguous"
+)
+
+// FieldError describes a validation error for a single field of an
+// address. Value is the value that failed validation and Level is the
+// subdivision (AdministrativeArea, Locality or DependentLocality) the
+// error relates to, if any. Err is the sentinel error, such as
+// ErrInvalidPostCode, which can be checked using errors.Is.
+//
+// Suggestions contains possible corrections for an invalid value.
+// For subdivisions, these are the IDs of the closest subdivisions.
+// For post codes, these are valid post codes that only differ from
+// the value in formatting.
+//
+// If the post code does not belong to a subdivision of the address,
+// BelongsTo contains the subdivisions it does belong to, so that it
+// can be determined whether the post code or the subdivision is wrong.
+type FieldError struct {
+	Field       Field
+	Code        ErrorCode
+	Value       string
+	Level       Field
+	Err         error
+	Suggestions []string
+	BelongsTo   []SubdivisionPath
+}
+
+func (e *FieldError) Error() string {
+	switch {
+	case e.Code == CodeMissing || e.Code == CodeUnsupported:
+		return fmt.Sprintf("%s:%s", e.Code, e.Field)
+	case e.Code == CodeMismatch:
+		return fmt.Sprintf("%s:%s:%s", e.Code, e.Field, e.Level)
+	case e.Err != nil:
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("invalid:%s", e.Field)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors is the error returned by Validate, NewValid and
+// Normalize. It contains an entry for each problem found in the address.
+//
+// errors.Is can be used to check for sentinel errors such as
+// ErrInvalidPostCode. errors.As can be used to get the
+// ValidationErrors, or an ErrMissingRequiredFields or
+// ErrUnsupportedFields listing all missing or unsupported fields.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	es := make([]error, len(e))
+	for i, err := range e {
+		es[i] = err
+	}
+
+	return ListFormatFunc(es)
+}
+
+// ErrorOrNil returns nil if there are no errors, so
+// that the result can be returned as an error.
+func (e ValidationErrors) ErrorOrNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Is reports whether any of the errors matches the target.
+func (e ValidationErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first error that matches the target. An
+// ErrMissingRequiredFields or ErrUnsupportedFields target
+// is set to the list of all missing or unsupported fields.
+func (e ValidationErrors) As(target interface{}) bool {
+	switch t := target.(type) {
+	case *ErrMissingRequiredFields:
+		fields := e.fields(CodeMissing)
+		if len(fields) == 0 {
+			return false
+		}
+		*t = ErrMissingRequiredFields{Fields: fields}
+		return true
+
+	case *ErrUnsupportedFields:
+		fields := e.fields(CodeUnsupported)
+		if len(fields) == 0 {
+			return false
+		}
+		*t = ErrUnsupportedFields{Fields: fields}
+		return true
+	}
+
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e ValidationErrors) fields(code ErrorCode) []Field {
+	var fields []Field
+	for _, err := range e {
+		if err.Code == code {
+			fields = append(fields, err.Field)
+		}
+	}
+
+	return fields
+}
@@ -34,6 +34,9 @@ type ExternalCountry struct {
 	LocalityNameType           *DisplayFieldName                  `json:"locality_name_type"`
 	DependentLocalityNameType  *DisplayFieldName                  `json:"dependent_locality_name_type"`
 	PostCodeNameType           *DisplayFieldName                  `json:"post_code_name_type"`
+	PostCodeExamples           []string                           `json:"post_code_examples"`
+	PostCodeMask               string                             `json:"post_code_mask"`
+	PostCodeHint               string                             `json:"post_code_hint"`
 	AdministrativeAreas        map[string]AdministrativeAreaSlice `json:"administrative_areas"`
 }
 
@@ -44,6 +47,12 @@ func Externalize(c country) ExternalCountry {
 		LocalityNameType:           fieldNameToDisplay(c.LocalityNameType),
 		DependentLocalityNameType:  fieldNameToDisplay(c.DependentLocalityNameType),
 		PostCodeNameType:           fieldNameToDisplay(c.PostCodeNameType),
+		PostCodeMask:               postCodeMask(c),
+		PostCodeHint:               postCodeHint(c),
+	}
+
+	if len(c.PostCodeRegex.examples) > 0 {
+		data.PostCodeExamples = append([]string(nil), c.PostCodeRegex.examples...)
 	}
 
 	var required []*DisplayField
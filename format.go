@@ -0,0 +1,272 @@
+package libaddress
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// FormatOption configures how an address is rendered
+// by Format.
+type FormatOption func(*formatOptions)
+
+type formatOptions struct {
+	language string
+}
+
+// FormatLanguage sets the language used to look up the names of
+// localities and dependent localities. If the country does not
+// have subdivisions in the language, the default language of the
+// country is used.
+func FormatLanguage(language string) FormatOption {
+	return func(o *formatOptions) {
+		o.language = language
+	}
+}
+
+// Format renders an address into postal lines ordered according to
+// the address format of its country. Empty fields are dropped along
+// with their surrounding separators, and each line of the street
+// address is rendered as a separate line. The country can be given
+// in any form accepted by NormalizeCountryCode. The address should be
+// validated before it is formatted.
+func Format(address Address, opts ...FormatOption) ([]string, error) {
+	return format(address, false, opts)
+}
+
+// FormatLatin renders an address into postal lines like Format, but
+// uses the latinized address format of its country when there is one.
+// Administrative areas, localities and dependent localities are
+// rendered using their latinized names, so that the address can be
+// read by international carriers. The language option is ignored for
+// countries with latinized subdivision names.
+func FormatLatin(address Address, opts ...FormatOption) ([]string, error) {
+	return format(address, true, opts)
+}
+
+func format(address Address, latinized bool, opts []FormatOption) ([]string, error) {
+	address.Country = countryCode(address.Country)
+
+	if !generated.hasCountry(address.Country) {
+		return nil, ErrInvalidCountryCode
+	}
+
+	options := formatOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	data := generated.getCountry(address.Country)
+	addressFormat := data.Format
+
+	if latinized && data.LatinizedFormat != "" {
+		addressFormat = data.LatinizedFormat
+	}
+
+	var values map[Field]string
+	lang := generated.normalizeLanguage(address.Country, options.language)
+
+	if latinized && hasLatinizedSubdivisions(data) {
+		values = latinizedFormatValues(address)
+		lang = latinizedLanguage
+	} else {
+		values = formatValues(address, options.language)
+	}
+
+	values[PostCode] = formatPostCode(data, values[PostCode])
+	applyUpper(values, data.Upper, lang)
+
+	return renderFormat(addressFormat, values), nil
+}
+
+type formatToken struct {
+	field   Field
+	literal string
+}
+
+// parseFormat splits an address format such as %N%n%O%n%A%n%C %S %Z
+// into lines of field and literal tokens.
+func parseFormat(format string) [][]formatToken {
+	var lines [][]formatToken
+
+	for _, line := range strings.Split(format, "%n") {
+		var tokens []formatToken
+		literal := ""
+
+		for i := 0; i < len(line); i++ {
+			if line[i] == '%' && i+1 < len(line) {
+				if field, ok := fieldFromKey(line[i+1 : i+2]); ok {
+					if literal != "" {
+						tokens = append(tokens, formatToken{literal: literal})
+						literal = ""
+					}
+					tokens = append(tokens, formatToken{field: field})
+					i++
+					continue
+				}
+			}
+			literal += line[i : i+1]
+		}
+
+		if literal != "" {
+			tokens = append(tokens, formatToken{literal: literal})
+		}
+
+		lines = append(lines, tokens)
+	}
+
+	return lines
+}
+
+func fieldFromKey(key string) (Field, bool) {
+	for field := Name; field <= SortingCode; field++ {
+		if field.Key() == key {
+			return field, true
+		}
+	}
+	return 0, false
+}
+
+func formatValues(address Address, language string) map[Field]string {
+	var streetAddress []string
+	for _, line := range address.StreetAddress {
+		if line = strings.TrimSpace(line); line != "" {
+			streetAddress = append(streetAddress, line)
+		}
+	}
+
+	values := map[Field]string{
+		Name:          strings.TrimSpace(address.Name),
+		Organization:  strings.TrimSpace(address.Organization),
+		StreetAddress: strings.Join(streetAddress, "\n"),
+		PostCode:      strings.TrimSpace(address.PostCode),
+		SortingCode:   strings.TrimSpace(address.SortingCode),
+	}
+
+	cc := address.Country
+	areaID := strings.TrimSpace(address.AdministrativeArea)
+	localityID := strings.TrimSpace(address.Locality)
+	dependentLocalityID := strings.TrimSpace(address.DependentLocality)
+
+	// Administrative areas are rendered using their postal key
+	// (e.g. VIC in AU or 東京都 in JP) as expected by the postal
+	// operator. Localities and dependent localities are rendered
+	// using their names.
+	values[AdministrativeArea] = areaID
+	if postalKey := generated.getAdministrativeAreaPostalKey(cc, areaID); postalKey != "" {
+		values[AdministrativeArea] = postalKey
+	}
+
+	values[Locality] = localityID
+	if name := generated.getLocalityName(cc, areaID, localityID, language); name != "" {
+		values[Locality] = name
+	}
+
+	values[DependentLocality] = dependentLocalityID
+	if name := generated.getDependentLocalityName(cc, areaID, localityID, dependentLocalityID, language); name != "" {
+		values[DependentLocality] = name
+	}
+
+	return values
+}
+
+// latinizedLanguage is the language latinized subdivision
+// names are generated under.
+const latinizedLanguage = "en"
+
+func hasLatinizedSubdivisions(c country) bool {
+	if c.DefaultLanguage == latinizedLanguage {
+		return false
+	}
+	_, ok := c.AdministrativeAreas[latinizedLanguage]
+	return ok
+}
+
+func latinizedFormatValues(address Address) map[Field]string {
+	values := formatValues(address, latinizedLanguage)
+
+	cc := address.Country
+	areaID := strings.TrimSpace(address.AdministrativeArea)
+
+	if name := generated.getAdministrativeAreaName(cc, areaID, latinizedLanguage); name != "" {
+		values[AdministrativeArea] = name
+	}
+
+	return values
+}
+
+// applyUpper uppercases the fields that the postal operator of a
+// country requires to be in upper case. The casing rules of the
+// language are used, so that for example the dotted i in Turkish
+// is uppercased correctly.
+func applyUpper(values map[Field]string, upper map[Field]struct{}, lang string) {
+	caser := upperCaser(lang)
+
+	for field := range upper {
+		if value, ok := values[field]; ok {
+			values[field] = caser.String(value)
+		}
+	}
+}
+
+func upperCaser(lang string) cases.Caser {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		tag = language.Und
+	}
+
+	return cases.Upper(tag)
+}
+
+func renderFormat(format string, values map[Field]string) []string {
+	var lines []string
+
+	for _, tokens := range parseFormat(format) {
+		// The street address may span multiple lines
+		for _, line := range strings.Split(renderLine(tokens, values), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	return lines
+}
+
+// renderLine renders a single line of an address format. A literal
+// preceding a field is only rendered if the field is not empty and
+// it is either the first token on the line or another field has
+// already been rendered. A trailing literal is only rendered if the
+// field before it is not empty.
+func renderLine(tokens []formatToken, values map[Field]string) string {
+	if len(tokens) == 1 && tokens[0].field == 0 {
+		return tokens[0].literal
+	}
+
+	var b strings.Builder
+	rendered := false
+
+	for i, token := range tokens {
+		if token.field == 0 {
+			continue
+		}
+
+		value := values[token.field]
+		if value == "" {
+			continue
+		}
+
+		if i > 0 && tokens[i-1].field == 0 && (rendered || i == 1) {
+			b.WriteString(tokens[i-1].literal)
+		}
+
+		b.WriteString(value)
+		rendered = true
+	}
+
+	if n := len(tokens); n > 1 && tokens[n-1].field == 0 && values[tokens[n-2].field] != "" {
+		b.WriteString(tokens[n-1].literal)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,294 @@
+package libaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+
+	tests := []struct {
+		Address  Address
+		Options  []FormatOption
+		Expected []string
+	}{
+		{
+			Address: New(
+				WithName("John Smith"),
+				WithOrganization("Company Pty Ltd"),
+				WithStreetAddress([]string{
+					"Level 1",
+					"525 Collins Street",
+				}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Expected: []string{
+				"Company Pty Ltd",
+				"John Smith",
+				"Level 1",
+				"525 Collins Street",
+				"MELBOURNE VIC 3000",
+			},
+		},
+		{
+			Address: New(
+				WithName("John Smith"),
+				WithStreetAddress([]string{
+					"1600 Amphitheatre Parkway",
+				}),
+				WithLocality("Mountain View"),
+				WithPostCode("94043"),
+				WithCountry("US"),
+			),
+			Expected: []string{
+				"John Smith",
+				"1600 Amphitheatre Parkway",
+				"MOUNTAIN VIEW 94043",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"1600 Amphitheatre Parkway",
+				}),
+				WithAdministrativeArea("CA"),
+				WithPostCode("94043"),
+				WithCountry("US"),
+			),
+			Expected: []string{
+				"1600 Amphitheatre Parkway",
+				"CA 94043",
+			},
+		},
+		{
+			Address: New(
+				WithName("山田太郎"),
+				WithStreetAddress([]string{
+					"千代田区丸の内1-1-1",
+				}),
+				WithAdministrativeArea("13"),
+				WithPostCode("100-0005"),
+				WithCountry("JP"),
+			),
+			Expected: []string{
+				"〒100-0005",
+				"東京都",
+				"千代田区丸の内1-1-1",
+				"山田太郎",
+			},
+		},
+		{
+			Address: New(
+				WithName("홍길동"),
+				WithStreetAddress([]string{
+					"장량로 17번길",
+				}),
+				WithDependentLocality("북구"),
+				WithLocality("포항시"),
+				WithAdministrativeArea("47"),
+				WithPostCode("37554"),
+				WithCountry("KR"),
+			),
+			Expected: []string{
+				"경상북도 포항시북구",
+				"장량로 17번길",
+				"홍길동",
+				"37554",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"No.1 Jianguomenwai Avenue",
+				}),
+				WithDependentLocality("临翔区"),
+				WithLocality("临沧市"),
+				WithAdministrativeArea("53"),
+				WithPostCode("677000"),
+				WithCountry("CN"),
+			),
+			Expected: []string{
+				"677000",
+				"云南省临沧市临翔区",
+				"No.1 Jianguomenwai Avenue",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"Torggatan 2",
+				}),
+				WithLocality("Mariehamn"),
+				WithCountry("AX"),
+			),
+			Expected: []string{
+				"Torggatan 2",
+				"MARIEHAMN",
+				"ÅLAND",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"10 Downing Street",
+				}),
+				WithLocality("London"),
+				WithPostCode("sw1a 2aa"),
+				WithCountry("GB"),
+			),
+			Expected: []string{
+				"10 Downing Street",
+				"LONDON",
+				"SW1A 2AA",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"1 Calle Fortaleza",
+				}),
+				WithLocality("San Juan"),
+				WithPostCode("PR 00901"),
+				WithCountry("PR"),
+			),
+			Expected: []string{
+				"1 CALLE FORTALEZA",
+				"SAN JUAN PR 00901",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"Mannerheimintie 1",
+				}),
+				WithLocality("Helsinki"),
+				WithPostCode("FI-00100"),
+				WithCountry("FI"),
+			),
+			Expected: []string{
+				"Mannerheimintie 1",
+				"FI-00100 HELSINKI",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"Atatürk Caddesi 1",
+				}),
+				WithLocality("izmir"),
+				WithPostCode("35210"),
+				WithCountry("TR"),
+			),
+			Expected: []string{
+				"Atatürk Caddesi 1",
+				"35210 İZMİR",
+			},
+		},
+		{
+			Address: Address{
+				Country:            "aus",
+				StreetAddress:      []string{"525 Collins Street"},
+				Locality:           "Melbourne",
+				AdministrativeArea: "VIC",
+				PostCode:           "3000",
+			},
+			Expected: []string{
+				"525 Collins Street",
+				"MELBOURNE VIC 3000",
+			},
+		},
+	}
+
+	for i, testCase := range tests {
+		lines, err := Format(testCase.Address, testCase.Options...)
+
+		if err != nil {
+			t.Errorf("Unexpected error formatting address in test case %d: %s", i, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(lines, testCase.Expected) {
+			t.Errorf("Formatted address in test case %d does not match expected lines, got %q", i, lines)
+		}
+	}
+
+	if _, err := Format(New(WithCountry("XX"))); err != ErrInvalidCountryCode {
+		t.Errorf("Expected ErrInvalidCountryCode when formatting an address with an invalid country, got %v", err)
+	}
+}
+
+func TestFormatLatin(t *testing.T) {
+
+	tests := []struct {
+		Address  Address
+		Expected []string
+	}{
+		{
+			Address: New(
+				WithName("Li Wei"),
+				WithStreetAddress([]string{
+					"No.1 Jianguomenwai Avenue",
+				}),
+				WithDependentLocality("临翔区"),
+				WithLocality("临沧市"),
+				WithAdministrativeArea("53"),
+				WithPostCode("677000"),
+				WithCountry("CN"),
+			),
+			Expected: []string{
+				"Li Wei",
+				"No.1 Jianguomenwai Avenue",
+				"Linxiang Qu",
+				"Lincang Shi",
+				"YUNNAN SHENG, 677000",
+			},
+		},
+		{
+			Address: New(
+				WithName("Taro Yamada"),
+				WithStreetAddress([]string{
+					"1-1-1 Marunouchi, Chiyoda-ku",
+				}),
+				WithAdministrativeArea("13"),
+				WithPostCode("100-0005"),
+				WithCountry("JP"),
+			),
+			Expected: []string{
+				"Taro Yamada",
+				"1-1-1 Marunouchi, Chiyoda-ku, TOKYO",
+				"100-0005",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"525 Collins Street",
+				}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Expected: []string{
+				"525 Collins Street",
+				"MELBOURNE VIC 3000",
+			},
+		},
+	}
+
+	for i, testCase := range tests {
+		lines, err := FormatLatin(testCase.Address)
+
+		if err != nil {
+			t.Errorf("Unexpected error formatting address in test case %d: %s", i, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(lines, testCase.Expected) {
+			t.Errorf("Latinized address in test case %d does not match expected lines, got %q", i, lines)
+		}
+	}
+}
@@ -1,12 +1,12 @@
 package libaddress
 
-import "strings"
-
 // WithCountry sets the country code of an address.
-// The country code must be an ISO 3166-1 country code.
+// The country code must be an ISO 3166-1 alpha-2, alpha-3
+// or numeric country code, and is set to the alpha-2 code
+// (see NormalizeCountryCode).
 func WithCountry(cc string) func(*Address) {
 	return func(a *Address) {
-		a.Country = strings.ToUpper(cc)
+		a.Country = countryCode(cc)
 	}
 }
 
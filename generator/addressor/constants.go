@@ -11,6 +11,10 @@ var (
 	ADDRESS_FORMAT_REGEX = regexp.MustCompile(`%[NOADCSZX]`)
 	REMOVE_LANG_REGEX    = regexp.MustCompile(`--.*`)
 
+	// ISO_SUBDIVISION_CODE_REGEX matches the part of an ISO 3166-2
+	// subdivision code after the country code, such as VIC in AU-VIC.
+	ISO_SUBDIVISION_CODE_REGEX = regexp.MustCompile(`^[A-Z\d]{1,3}$`)
+
 	POST_PREFIX_FIXES = map[string]string{
 		"PR": "PR ",
 	}
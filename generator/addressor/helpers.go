@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/getsafepay/libaddress"
 	"regexp"
+	"strings"
 )
 
 func getAllowedFields(format string) map[libaddress.Field]struct{} {
@@ -129,3 +130,36 @@ func checkPostalCodeRegex(regex string, postalcodes []string) error {
 
 	return nil
 }
+
+// getPostCodeExamples splits a comma separated list
+// of example post codes from Google's data.
+func getPostCodeExamples(zipex string) []string {
+	var examples []string
+
+	for _, example := range strings.Split(zipex, ",") {
+		if example = strings.TrimSpace(example); example != "" {
+			examples = append(examples, example)
+		}
+	}
+
+	return examples
+}
+
+// hasNonISOSubdivisionIDs checks if the administrative areas of a
+// country are identified by keys that are not ISO 3166-2 subdivision
+// codes. This is the case if the country has subkeys but no ISO ids,
+// and the keys are names (e.g. HK) rather than codes. The keys of
+// some countries without ISO ids, such as ES, are the ISO codes.
+func hasNonISOSubdivisionIDs(ec externalCountry) bool {
+	if ec.SubKeys == "" || ec.SubISOIDs != "" {
+		return false
+	}
+
+	for _, key := range strings.Split(ec.SubKeys, "~") {
+		if !ISO_SUBDIVISION_CODE_REGEX.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
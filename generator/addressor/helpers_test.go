@@ -0,0 +1,42 @@
+package addressor
+
+import (
+	"testing"
+)
+
+func TestHasNonISOSubdivisionIDs(t *testing.T) {
+
+	// The sub_keys and sub_isoids of the countries are
+	// abbreviated from the source data.
+	tests := []struct {
+		Country  externalCountry
+		Expected bool
+	}{
+		{
+			Country:  externalCountry{ID: "data/ES", SubKeys: "C~VI~AB~A"},
+			Expected: false,
+		},
+		{
+			Country:  externalCountry{ID: "data/HK", SubKeys: "Kowloon~Hong Kong Island~New Territories"},
+			Expected: true,
+		},
+		{
+			Country:  externalCountry{ID: "data/KY", SubKeys: "Cayman Brac~Grand Cayman~Little Cayman"},
+			Expected: true,
+		},
+		{
+			Country:  externalCountry{ID: "data/AU", SubKeys: "ACT~NSW~NT", SubISOIDs: "ACT~NSW~NT"},
+			Expected: false,
+		},
+		{
+			Country:  externalCountry{ID: "data/AC"},
+			Expected: false,
+		},
+	}
+
+	for i, testCase := range tests {
+		if result := hasNonISOSubdivisionIDs(testCase.Country); result != testCase.Expected {
+			t.Errorf("Expected non ISO subdivision IDs to be %t for %s in test case %d, got %t", testCase.Expected, testCase.Country.ID, i, result)
+		}
+	}
+}
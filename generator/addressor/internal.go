@@ -8,6 +8,7 @@ import (
 
 type postCodeRegex struct {
 	regex            string
+	examples         []string
 	subdivisionRegex map[string]postCodeRegex
 }
 
@@ -28,6 +29,17 @@ func (p postCodeRegex) toCode() string {
 		regex: `+"`%s`,", p.regex,
 	)
 
+	if len(p.examples) > 0 {
+		str += `
+examples: []string{`
+
+		for _, example := range p.examples {
+			str += fmt.Sprintf(`%q,`, example)
+		}
+
+		str += `},`
+	}
+
 	if len(p.subdivisionRegex) > 0 {
 		str += `
 subdivisionRegex: map[string]postCodeRegex{
@@ -153,6 +165,11 @@ type Country struct {
 	RequiredFields map[libaddress.Field]struct{}
 	Upper          map[libaddress.Field]struct{}
 
+	// NonISOSubdivisionIDs is set if the administrative area
+	// IDs are keys rather than ISO 3166-2 subdivision codes,
+	// because the country does not have ISO IDs (e.g. HK).
+	NonISOSubdivisionIDs bool
+
 	AdministrativeAreas map[string]administrativeAreaSlice
 }
 
@@ -177,7 +194,7 @@ DefaultLanguage: "%s",`,
 PostCodePrefix: "%s",`, c.PostCodePrefix)
 	}
 
-	if c.PostCodeRegex.regex != "" || len(c.PostCodeRegex.subdivisionRegex) > 0 {
+	if c.PostCodeRegex.regex != "" || len(c.PostCodeRegex.examples) > 0 || len(c.PostCodeRegex.subdivisionRegex) > 0 {
 		str += fmt.Sprintf(`
 PostCodeRegex: postCodeRegex%s,`, c.PostCodeRegex.toCode())
 	}
@@ -277,6 +294,11 @@ Upper: map[Field]struct{}{`,
 },`
 	}
 
+	if c.NonISOSubdivisionIDs {
+		str += `
+NonISOSubdivisionIDs: true,`
+	}
+
 	if len(c.AdministrativeAreas) > 0 {
 		// Generate languages first in order to avoid
 		// huge diffs when updating the address data
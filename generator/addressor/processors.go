@@ -94,8 +94,14 @@ func processAdministrativeAreas(ec externalCountry, lang string) (
 		}
 
 		if ec.SubZips != "" && subZips[i] != "" {
+			var examples []string
+			if ec.SubZipExs != "" && i < len(subZipExs) {
+				examples = getPostCodeExamples(subZipExs[i])
+			}
+
 			postCodeRegexMap[isoID] = postCodeRegex{
-				regex: fmt.Sprintf("^%s", subZips[i]),
+				regex:    fmt.Sprintf("^%s", subZips[i]),
+				examples: examples,
 			}
 		}
 
@@ -261,8 +267,14 @@ func processLocalities(esd externalSubdivision, lang string) (
 		}
 
 		if esd.SubZips != "" && subZips[i] != "" {
+			var examples []string
+			if esd.SubZipExs != "" && i < len(subZipExs) {
+				examples = getPostCodeExamples(subZipExs[i])
+			}
+
 			postCodeRegexMap[key] = postCodeRegex{
-				regex: fmt.Sprintf("^%s", subZips[i]),
+				regex:    fmt.Sprintf("^%s", subZips[i]),
+				examples: examples,
 			}
 		}
 		// latinized locality
@@ -420,8 +432,14 @@ func processDependentLocalities(esd externalSubdivision) (
 		}
 
 		if esd.SubZips != "" && subZips[i] != "" {
+			var examples []string
+			if esd.SubZipExs != "" && i < len(subZipExs) {
+				examples = getPostCodeExamples(subZipExs[i])
+			}
+
 			postCodeRegexMap[key] = postCodeRegex{
-				regex: fmt.Sprintf("^%s", subZips[i]),
+				regex:    fmt.Sprintf("^%s", subZips[i]),
+				examples: examples,
 			}
 		}
 
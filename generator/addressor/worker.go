@@ -89,7 +89,8 @@ func (w *Worker) Start() {
 					Name: ec.Name,
 
 					PostCodeRegex: postCodeRegex{
-						regex: ec.Zip,
+						regex:    ec.Zip,
+						examples: getPostCodeExamples(ec.Zipex),
 					},
 
 					Format:          ec.Fmt,
@@ -196,6 +197,7 @@ func (w *Worker) Start() {
 					}
 
 					c.AdministrativeAreas = make(map[string]administrativeAreaSlice)
+					c.NonISOSubdivisionIDs = hasNonISOSubdivisionIDs(ec)
 
 					// Get languages
 					languages := strings.Split(ec.Languages, "~")
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"crypto/sha256"
+	"flag"
 	"fmt"
 	"github.com/getsafepay/libaddress/generator/addressor"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"io/ioutil"
 	"log"
 	"net/http"
@@ -13,7 +18,21 @@ import (
 	"time"
 )
 
+var versionOnly = flag.Bool(
+	"version-only",
+	false,
+	"only regenerate version.generated.go from the existing data.generated.go, "+
+		"marking the data as modified. Use this after editing the data by hand.",
+)
+
 func main() {
+	flag.Parse()
+
+	if *versionOnly {
+		writeVersionOfExistingData()
+		return
+	}
+
 	fmt.Println(
 		"Downloading address data from %s."+
 			"This may take a few minutes.\n",
@@ -130,7 +149,106 @@ func main() {
 		log.Fatalf("Error writing data.go: %s", err.Error())
 	}
 
+	// Record the version of the data, so that it can be reported
+	// and changes in validation can be correlated with updates.
+	fmt.Println("Generating data version...")
+
+	numCountries := 0
+	for _, country := range sortedCountries {
+		if country != "ZZ" {
+			numCountries++
+		}
+	}
+
+	writeVersion(start, url, numCountries, formatted, false)
+
 	timeTaken := time.Since(start)
 
 	fmt.Printf("Total time taken: %s\n", timeTaken)
 }
+
+// writeVersion writes version.generated.go, which
+// records the version of the generated data.
+func writeVersion(generatedAt time.Time, url string, numCountries int, data []byte, modified bool) {
+	generatedAt = generatedAt.UTC()
+
+	version := fmt.Sprintf(`
+		// Code generated by libaddress. DO NOT EDIT.
+		package libaddress
+
+		import "time"
+
+		var generatedVersion = DataVersionInfo{
+			GeneratedAt: time.Date(%d, %d, %d, %d, %d, %d, 0, time.UTC),
+			SourceURL: %q,
+			Countries: %d,
+			Hash: "%x",
+			Modified: %t,
+		}
+	`,
+		generatedAt.Year(), generatedAt.Month(), generatedAt.Day(),
+		generatedAt.Hour(), generatedAt.Minute(), generatedAt.Second(),
+		url, numCountries, sha256.Sum256(data), modified,
+	)
+
+	formatted, err := format.Source([]byte(version))
+	if err != nil {
+		log.Fatalf("Error formatting generated version: %s", err.Error())
+	}
+
+	err = ioutil.WriteFile("version.generated.go", formatted, os.ModePerm)
+
+	if err != nil {
+		log.Fatalf("Error writing version.generated.go: %s", err.Error())
+	}
+}
+
+// writeVersionOfExistingData writes version.generated.go for the
+// data.generated.go on disk. The data is marked as modified, as it
+// was not downloaded and generated in this run.
+func writeVersionOfExistingData() {
+	data, err := ioutil.ReadFile("data.generated.go")
+	if err != nil {
+		log.Fatalf("Error reading data.generated.go: %s", err.Error())
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "data.generated.go", data, 0)
+	if err != nil {
+		log.Fatalf("Error parsing data.generated.go: %s", err.Error())
+	}
+
+	numCountries := 0
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != "generated" || len(spec.Values) != 1 {
+			return true
+		}
+
+		countries, ok := spec.Values[0].(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+
+		for _, element := range countries.Elts {
+			kv, ok := element.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+
+			if key, ok := kv.Key.(*ast.BasicLit); ok && key.Value != `"ZZ"` {
+				numCountries++
+			}
+		}
+
+		return false
+	})
+
+	if numCountries == 0 {
+		log.Fatalf("Error counting the countries in data.generated.go")
+	}
+
+	url := fmt.Sprintf("%s/data", addressor.GOOGLE_ADDRESS_URL)
+	writeVersion(time.Now(), url, numCountries, data, true)
+
+	fmt.Println("Generated version.generated.go")
+}
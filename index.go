@@ -0,0 +1,125 @@
+package libaddress
+
+import "sync"
+
+// subdivisionIndex maps the IDs of the subdivisions of a country to
+// the subdivisions in the generated data, so that they can be looked up
+// without scanning the lists of subdivisions. The administrative areas
+// are indexed by language and ID.
+type subdivisionIndex map[string]map[string]*administrativeAreaEntry
+
+type administrativeAreaEntry struct {
+	area       *administrativeArea
+	localities map[string]*localityEntry
+}
+
+type localityEntry struct {
+	locality            *locality
+	dependentLocalities map[string]*dependentLocality
+}
+
+// indexes caches the subdivision index of each country. An
+// index is built the first time the country is looked up.
+var indexes sync.Map
+
+// subdivisionIndex returns the index of a country. Countries that
+// do not exist have an empty index, which is not cached, so that
+// looking up arbitrary country codes does not grow the cache.
+func (d data) subdivisionIndex(cc string) subdivisionIndex {
+	if index, ok := indexes.Load(cc); ok {
+		return index.(subdivisionIndex)
+	}
+
+	c, ok := d[cc]
+	if !ok {
+		return nil
+	}
+
+	index, _ := indexes.LoadOrStore(cc, newSubdivisionIndex(c))
+
+	return index.(subdivisionIndex)
+}
+
+func newSubdivisionIndex(c country) subdivisionIndex {
+	index := subdivisionIndex{}
+
+	for lang, areas := range c.AdministrativeAreas {
+		areaEntries := make(map[string]*administrativeAreaEntry, len(areas))
+
+		for i := range areas {
+			area := &areas[i]
+			if _, ok := areaEntries[area.ID]; ok {
+				continue
+			}
+
+			areaEntry := &administrativeAreaEntry{
+				area: area,
+			}
+
+			if len(area.Localities) > 0 {
+				areaEntry.localities = make(map[string]*localityEntry, len(area.Localities))
+			}
+
+			for j := range area.Localities {
+				l := &area.Localities[j]
+				if _, ok := areaEntry.localities[l.ID]; ok {
+					continue
+				}
+
+				localityEntry := &localityEntry{
+					locality: l,
+				}
+
+				if len(l.DependentLocalities) > 0 {
+					localityEntry.dependentLocalities = make(map[string]*dependentLocality, len(l.DependentLocalities))
+				}
+
+				for k := range l.DependentLocalities {
+					dl := &l.DependentLocalities[k]
+					if _, ok := localityEntry.dependentLocalities[dl.ID]; !ok {
+						localityEntry.dependentLocalities[dl.ID] = dl
+					}
+				}
+
+				areaEntry.localities[l.ID] = localityEntry
+			}
+
+			areaEntries[area.ID] = areaEntry
+		}
+
+		index[lang] = areaEntries
+	}
+
+	return index
+}
+
+// administrativeArea returns the administrative area with
+// the ID in the language.
+func (i subdivisionIndex) administrativeArea(lang, areaID string) (*administrativeAreaEntry, bool) {
+	entry, ok := i[lang][areaID]
+	return entry, ok
+}
+
+// locality returns the locality with the ID in the
+// administrative area in the language.
+func (i subdivisionIndex) locality(lang, areaID, localityID string) (*localityEntry, bool) {
+	area, ok := i.administrativeArea(lang, areaID)
+	if !ok {
+		return nil, false
+	}
+
+	entry, ok := area.localities[localityID]
+	return entry, ok
+}
+
+// dependentLocality returns the dependent locality with the ID
+// in the locality and administrative area in the language.
+func (i subdivisionIndex) dependentLocality(lang, areaID, localityID, dependentLocalityID string) (*dependentLocality, bool) {
+	l, ok := i.locality(lang, areaID, localityID)
+	if !ok {
+		return nil, false
+	}
+
+	dl, ok := l.dependentLocalities[dependentLocalityID]
+	return dl, ok
+}
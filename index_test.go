@@ -0,0 +1,66 @@
+package libaddress
+
+import (
+	"testing"
+)
+
+func TestSubdivisionIndex(t *testing.T) {
+
+	// Check that every subdivision in the generated data can be
+	// found using the index.
+	for cc, c := range generated {
+		index := generated.subdivisionIndex(cc)
+
+		for lang, areas := range c.AdministrativeAreas {
+			for _, area := range areas {
+				if _, ok := index.administrativeArea(lang, area.ID); !ok {
+					t.Errorf("Administrative area %s in %s (%s) is not indexed", area.ID, cc, lang)
+				}
+
+				for _, l := range area.Localities {
+					if _, ok := index.locality(lang, area.ID, l.ID); !ok {
+						t.Errorf("Locality %s in %s/%s (%s) is not indexed", l.ID, cc, area.ID, lang)
+					}
+
+					for _, dl := range l.DependentLocalities {
+						if _, ok := index.dependentLocality(lang, area.ID, l.ID, dl.ID); !ok {
+							t.Errorf("Dependent locality %s in %s/%s/%s (%s) is not indexed", dl.ID, cc, area.ID, l.ID, lang)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if _, ok := generated.subdivisionIndex("CN").locality("zh", "53", "ASDF"); ok {
+		t.Errorf("Expected invalid locality to not be found in the index")
+	}
+
+	// Unknown countries must not be cached, as the country codes
+	// may come from user input.
+	for _, cc := range []string{"XX", "asdf"} {
+		if index := generated.subdivisionIndex(cc); index != nil {
+			t.Errorf("Expected unknown country %s to have an empty index, got %v", cc, index)
+		}
+
+		if _, ok := indexes.Load(cc); ok {
+			t.Errorf("Expected index of unknown country %s to not be cached", cc)
+		}
+	}
+
+	AdministrativeAreaName("XX", "VIC", "en")
+	LookupSubdivisions(Address{Country: "XX", AdministrativeArea: "VIC"}, "en")
+	Localities("XX", "VIC", "en")
+
+	if _, ok := indexes.Load("XX"); ok {
+		t.Errorf("Expected looking up subdivisions of an unknown country to not cache an index")
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		generated.getDependentLocalityName("KR", "47", "포항시", "북구", "ko")
+	})
+
+	if allocs > 0 {
+		t.Errorf("Expected dependent locality name lookup to not allocate, got %v allocations", allocs)
+	}
+}
@@ -0,0 +1,109 @@
+package libaddress
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SubdivisionPath contains the IDs of an administrative
+// area and optionally, a locality and dependent locality
+// within it.
+type SubdivisionPath struct {
+	AdministrativeArea string
+	Locality           string
+	DependentLocality  string
+}
+
+// InferSubdivisions returns the administrative areas, localities and
+// dependent localities that a post code belongs to, using the post code
+// regular expressions of the subdivisions of the country. Each path is
+// as deep as the data allows: if a post code matches an administrative
+// area, but none of its localities, only the administrative area is
+// returned. The paths are sorted by their IDs.
+//
+// ErrInvalidCountryCode is returned if the country does not exist and
+// ErrInvalidPostCode is returned if the post code is not valid for
+// the country. If the country does not have post code regular
+// expressions for its subdivisions, no paths are returned.
+func InferSubdivisions(cc, postCode string) ([]SubdivisionPath, error) {
+	cc = countryCode(cc)
+
+	if !generated.hasCountry(cc) {
+		return nil, ErrInvalidCountryCode
+	}
+
+	data := generated.getCountry(cc)
+	postCode = strings.TrimSpace(postCode)
+
+	if postCode == "" || data.PostCodeRegex.regex == "" {
+		return nil, ErrInvalidPostCode
+	}
+
+	for _, candidate := range postCodeCandidates(data, postCode) {
+		if matchesPostCode(data, candidate) {
+			return inferSubdivisions(data.PostCodeRegex, candidate), nil
+		}
+	}
+
+	return nil, ErrInvalidPostCode
+}
+
+func inferSubdivisions(regex postCodeRegex, postCode string) []SubdivisionPath {
+	var paths []SubdivisionPath
+
+	for areaID, area := range regex.subdivisionRegex {
+		if !subdivisionPostCodeRegex(area.regex).MatchString(postCode) {
+			continue
+		}
+
+		var localities []SubdivisionPath
+		for localityID, l := range area.subdivisionRegex {
+			if !subdivisionPostCodeRegex(l.regex).MatchString(postCode) {
+				continue
+			}
+
+			var dependentLocalities []SubdivisionPath
+			for dependentLocalityID, dl := range l.subdivisionRegex {
+				if subdivisionPostCodeRegex(dl.regex).MatchString(postCode) {
+					dependentLocalities = append(dependentLocalities, SubdivisionPath{areaID, localityID, dependentLocalityID})
+				}
+			}
+
+			if len(dependentLocalities) == 0 {
+				dependentLocalities = []SubdivisionPath{{areaID, localityID, ""}}
+			}
+
+			localities = append(localities, dependentLocalities...)
+		}
+
+		if len(localities) == 0 {
+			localities = []SubdivisionPath{{AdministrativeArea: areaID}}
+		}
+
+		paths = append(paths, localities...)
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if paths[i].AdministrativeArea != paths[j].AdministrativeArea {
+			return paths[i].AdministrativeArea < paths[j].AdministrativeArea
+		}
+		if paths[i].Locality != paths[j].Locality {
+			return paths[i].Locality < paths[j].Locality
+		}
+		return paths[i].DependentLocality < paths[j].DependentLocality
+	})
+
+	return paths
+}
+
+// subdivisionPostCodeRegex compiles the post code regular expression of
+// a subdivision, which matches the start of the post codes in the
+// subdivision. Some expressions in the data, such as ^71[6-9]|72, are
+// alternations that are only anchored on their first branch, so every
+// branch is anchored to the start of the post code.
+func subdivisionPostCodeRegex(regex string) *regexp.Regexp {
+	return compileAnchoredRegex(&subdivisionRegexes, regex, func(regex string) string {
+		return `^(?:` + strings.TrimPrefix(regex, "^") + `)`
+	})
+}
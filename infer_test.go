@@ -0,0 +1,77 @@
+package libaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInferSubdivisions(t *testing.T) {
+
+	tests := []struct {
+		Country  string
+		PostCode string
+		Expected []SubdivisionPath
+	}{
+		{
+			Country:  "AU",
+			PostCode: "3000",
+			Expected: []SubdivisionPath{
+				{AdministrativeArea: "VIC"},
+			},
+		},
+		{
+			Country:  "us",
+			PostCode: "72201",
+			Expected: []SubdivisionPath{
+				{AdministrativeArea: "AR"},
+			},
+		},
+		{
+			Country:  "US",
+			PostCode: "94072", // The AR regex is ^71[6-9]|72
+			Expected: []SubdivisionPath{
+				{AdministrativeArea: "CA"},
+			},
+		},
+		{
+			Country:  "KR",
+			PostCode: "37554",
+			Expected: []SubdivisionPath{
+				{AdministrativeArea: "47", Locality: "포항시", DependentLocality: "북구"},
+			},
+		},
+		{
+			Country:  "JP",
+			PostCode: "100-0005",
+			Expected: []SubdivisionPath{
+				{AdministrativeArea: "13"},
+			},
+		},
+		{
+			Country:  "GB",
+			PostCode: "SW1A 2AA",
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range tests {
+		paths, err := InferSubdivisions(testCase.Country, testCase.PostCode)
+
+		if err != nil {
+			t.Errorf("Unexpected error inferring subdivisions in test case %d: %s", i, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(paths, testCase.Expected) {
+			t.Errorf("Inferred subdivisions in test case %d do not match expected subdivisions, got %v", i, paths)
+		}
+	}
+
+	if _, err := InferSubdivisions("US", "9404"); err != ErrInvalidPostCode {
+		t.Errorf("Expected ErrInvalidPostCode when inferring subdivisions from an invalid post code, got %v", err)
+	}
+
+	if _, err := InferSubdivisions("XX", "3000"); err != ErrInvalidCountryCode {
+		t.Errorf("Expected ErrInvalidCountryCode when inferring subdivisions in an invalid country, got %v", err)
+	}
+}
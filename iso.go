@@ -0,0 +1,75 @@
+package libaddress
+
+import (
+	"strings"
+)
+
+// SubdivisionISOCode returns the ISO 3166-2 code of an administrative
+// area, such as AU-VIC for VIC in AU or JP-13 for 13 in JP.
+// ErrInvalidCountryCode is returned if the country does not exist and
+// ErrInvalidAdministrativeArea is returned if the administrative area
+// does not exist. Some countries, such as HK, identify their
+// administrative areas using names instead of ISO codes, in which case
+// ErrNoISOSubdivisionCode is returned.
+func SubdivisionISOCode(cc, areaID string) (string, error) {
+	cc = countryCode(cc)
+
+	if !generated.hasCountry(cc) {
+		return "", ErrInvalidCountryCode
+	}
+
+	data := generated.getCountry(cc)
+	lang := generated.normalizeLanguage(cc, "")
+
+	if _, ok := generated.subdivisionIndex(cc).administrativeArea(lang, areaID); !ok {
+		return "", ErrInvalidAdministrativeArea
+	}
+
+	code := isoSubdivisionCode(data, areaID)
+	if code == "" {
+		return "", ErrNoISOSubdivisionCode
+	}
+
+	return code, nil
+}
+
+// ParseSubdivisionISOCode returns the country code and administrative
+// area ID of an ISO 3166-2 code, such as AU and VIC for AU-VIC. The
+// code is case insensitive. ErrInvalidISOSubdivisionCode is returned
+// if the code is malformed or the administrative area does not exist,
+// and ErrNoISOSubdivisionCode is returned if the administrative areas
+// of the country do not have ISO codes.
+func ParseSubdivisionISOCode(code string) (cc, areaID string, err error) {
+	parts := strings.SplitN(strings.ToUpper(strings.TrimSpace(code)), "-", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", ErrInvalidISOSubdivisionCode
+	}
+
+	cc, areaID = parts[0], parts[1]
+
+	if cc == "ZZ" || !generated.hasCountry(cc) {
+		return "", "", ErrInvalidCountryCode
+	}
+
+	data := generated.getCountry(cc)
+	if data.NonISOSubdivisionIDs {
+		return "", "", ErrNoISOSubdivisionCode
+	}
+
+	lang := generated.normalizeLanguage(cc, "")
+	if _, ok := generated.subdivisionIndex(cc).administrativeArea(lang, areaID); !ok {
+		return "", "", ErrInvalidISOSubdivisionCode
+	}
+
+	return cc, areaID, nil
+}
+
+// isoSubdivisionCode returns the ISO 3166-2 code of an administrative
+// area, or an empty string if the country does not use ISO codes.
+func isoSubdivisionCode(c country, areaID string) string {
+	if c.NonISOSubdivisionIDs || areaID == "" {
+		return ""
+	}
+
+	return c.ID + "-" + areaID
+}
@@ -0,0 +1,100 @@
+package libaddress
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubdivisionISOCode(t *testing.T) {
+
+	tests := []struct {
+		Country            string
+		AdministrativeArea string
+		Expected           string
+		Err                error
+	}{
+		{Country: "AU", AdministrativeArea: "VIC", Expected: "AU-VIC"},
+		{Country: "us", AdministrativeArea: "CA", Expected: "US-CA"},
+		{Country: "JP", AdministrativeArea: "13", Expected: "JP-13"},
+		{Country: "AU", AdministrativeArea: "ASDF", Err: ErrInvalidAdministrativeArea},
+		{Country: "ES", AdministrativeArea: "B", Expected: "ES-B"},
+		{Country: "HK", AdministrativeArea: "Kowloon", Err: ErrNoISOSubdivisionCode},
+		{Country: "KY", AdministrativeArea: "Grand Cayman", Err: ErrNoISOSubdivisionCode},
+		{Country: "XX", AdministrativeArea: "VIC", Err: ErrInvalidCountryCode},
+	}
+
+	for i, testCase := range tests {
+		code, err := SubdivisionISOCode(testCase.Country, testCase.AdministrativeArea)
+
+		if err != testCase.Err {
+			t.Errorf("Expected error %v in test case %d, got %v", testCase.Err, i, err)
+		}
+
+		if code != testCase.Expected {
+			t.Errorf("ISO code in test case %d does not match expected code %s, got %s", i, testCase.Expected, code)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		cc, areaID, err := ParseSubdivisionISOCode(code)
+		if err != nil {
+			t.Errorf("Unexpected error parsing ISO code in test case %d: %s", i, err)
+		}
+
+		if cc != strings.ToUpper(testCase.Country) || areaID != testCase.AdministrativeArea {
+			t.Errorf("Parsed ISO code in test case %d does not match the administrative area, got %s and %s", i, cc, areaID)
+		}
+	}
+}
+
+func TestParseSubdivisionISOCode(t *testing.T) {
+
+	tests := []struct {
+		Code               string
+		Country            string
+		AdministrativeArea string
+		Err                error
+	}{
+		{Code: "au-vic", Country: "AU", AdministrativeArea: "VIC"},
+		{Code: " KR-47 ", Country: "KR", AdministrativeArea: "47"},
+		{Code: "AU-ASDF", Err: ErrInvalidISOSubdivisionCode},
+		{Code: "AU", Err: ErrInvalidISOSubdivisionCode},
+		{Code: "AU-", Err: ErrInvalidISOSubdivisionCode},
+		{Code: "ES-M", Country: "ES", AdministrativeArea: "M"},
+		{Code: "HK-KOWLOON", Err: ErrNoISOSubdivisionCode},
+		{Code: "XX-VIC", Err: ErrInvalidCountryCode},
+	}
+
+	for i, testCase := range tests {
+		cc, areaID, err := ParseSubdivisionISOCode(testCase.Code)
+
+		if err != testCase.Err {
+			t.Errorf("Expected error %v in test case %d, got %v", testCase.Err, i, err)
+		}
+
+		if cc != testCase.Country || areaID != testCase.AdministrativeArea {
+			t.Errorf("Parsed ISO code in test case %d does not match expected administrative area, got %s and %s", i, cc, areaID)
+		}
+	}
+
+	// Every administrative area in a country with ISO codes must
+	// round trip.
+	for cc, c := range generated {
+		if c.NonISOSubdivisionIDs {
+			continue
+		}
+
+		for _, area := range AdministrativeAreas(cc, "") {
+			if area.ISOCode == "" {
+				t.Errorf("Expected administrative area %s in %s to have an ISO code", area.ID, cc)
+				continue
+			}
+
+			if parsedCC, areaID, err := ParseSubdivisionISOCode(area.ISOCode); err != nil || parsedCC != cc || areaID != area.ID {
+				t.Errorf("ISO code %s does not round trip, got %s, %s and %v", area.ISOCode, parsedCC, areaID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package libaddress
+
+import (
+	"regexp/syntax"
+	"strconv"
+	"strings"
+)
+
+// Characters used in post code masks.
+const (
+	maskDigit        = '9'
+	maskLetter       = 'A'
+	maskAlphanumeric = '*'
+	maskEscape       = '\\'
+)
+
+// maxHintExamples is the maximum number of
+// example post codes used in a hint.
+const maxHintExamples = 2
+
+// PostCodeMask returns an input mask for the post codes of a country,
+// such as 9999 in Australia, 99-999 in Poland and 9999-999 in Portugal.
+// In the mask, 9 is a digit, A is a letter and * is a letter or digit.
+// Other characters are written as is, with digits, A, * and \ escaped
+// using a backslash. The mask is derived from the post code regular
+// expression of the country. If the regular expression has optional or
+// variable length parts, such as in the US where ZIP+4 codes are
+// optional, the post codes cannot be described by a single mask and an
+// empty string is returned.
+//
+// Character classes that only allow some digits or letters, such as
+// [1-7], are written as 9 or A, so the mask describes the shape of the
+// post codes, and they must still be validated.
+func PostCodeMask(cc string) string {
+	return postCodeMask(generated.getCountry(countryCode(cc)))
+}
+
+// PostCodeHint returns a human readable hint for the post codes of
+// a country, such as "e.g. 2060, 3171", that can be shown in forms. If
+// the country does not have example post codes, the hint describes the
+// post code regular expression, such as "5 digits, optionally followed
+// by a space or - and 4 digits" in the US. An empty string is returned
+// if the regular expression is too complex to describe, such as one
+// with alternatives.
+func PostCodeHint(cc string) string {
+	return postCodeHint(generated.getCountry(countryCode(cc)))
+}
+
+func postCodeMask(c country) string {
+	if c.PostCodeRegex.regex == "" {
+		return ""
+	}
+
+	mask, _ := regexMask(c.PostCodeRegex.regex)
+	return mask
+}
+
+func postCodeHint(c country) string {
+	examples := c.PostCodeRegex.examples
+	if len(examples) > maxHintExamples {
+		examples = examples[:maxHintExamples]
+	}
+
+	if len(examples) > 0 {
+		return "e.g. " + strings.Join(examples, ", ")
+	}
+
+	if c.PostCodeRegex.regex == "" {
+		return ""
+	}
+
+	description, _ := regexDescription(c.PostCodeRegex.regex)
+	return description
+}
+
+// regexMask converts a regular expression into a mask. This is only
+// possible if the regular expression is a sequence of literals and
+// character classes repeated a fixed number of times.
+func regexMask(regex string) (string, bool) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	var mask strings.Builder
+	if !writeMask(&mask, re.Simplify()) {
+		return "", false
+	}
+
+	return mask.String(), mask.Len() > 0
+}
+
+func writeMask(mask *strings.Builder, re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return true
+
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return false
+		}
+
+		for _, r := range re.Rune {
+			writeMaskLiteral(mask, r)
+		}
+		return true
+
+	case syntax.OpCharClass:
+		char, ok := charClassMask(re.Rune)
+		if ok {
+			mask.WriteRune(char)
+		}
+		return ok
+
+	case syntax.OpCapture:
+		return writeMask(mask, re.Sub[0])
+
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !writeMask(mask, sub) {
+				return false
+			}
+		}
+		return true
+
+	case syntax.OpRepeat:
+		if re.Min != re.Max {
+			return false
+		}
+
+		for i := 0; i < re.Min; i++ {
+			if !writeMask(mask, re.Sub[0]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// writeMaskLiteral writes a literal character to a mask, escaping
+// characters that would otherwise be placeholders.
+func writeMaskLiteral(mask *strings.Builder, r rune) {
+	if (r >= '0' && r <= '9') || r == maskLetter || r == maskAlphanumeric || r == maskEscape {
+		mask.WriteRune(maskEscape)
+	}
+
+	mask.WriteRune(r)
+}
+
+// charClassMask returns the mask character for a character class,
+// which is given as pairs of runes for each range in the class.
+func charClassMask(ranges []rune) (rune, bool) {
+	digits, letters := false, false
+
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+
+		switch {
+		case lo >= '0' && hi <= '9':
+			digits = true
+		case lo >= 'A' && hi <= 'Z':
+			letters = true
+		default:
+			return 0, false
+		}
+	}
+
+	switch {
+	case digits && letters:
+		return maskAlphanumeric, true
+	case digits:
+		return maskDigit, true
+	case letters:
+		return maskLetter, true
+	}
+
+	return 0, false
+}
+
+// descriptionPart is a part of a post code in a description. Parts
+// made of character classes have a unit, such as digit, and are
+// repeated between min and max times. Optional parts are described
+// as following the parts before them.
+type descriptionPart struct {
+	text     string
+	unit     string
+	min, max int
+	optional bool
+}
+
+// Units of character classes in descriptions.
+var descriptionUnits = map[rune]string{
+	maskDigit:        "digit",
+	maskLetter:       "letter",
+	maskAlphanumeric: "letter or digit",
+}
+
+// regexDescription describes a regular expression in words. This is
+// only possible if the regular expression is a sequence of literals,
+// character classes and optional parts.
+func regexDescription(regex string) (string, bool) {
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	parts, ok := describeRegex(re)
+	if !ok || len(parts) == 0 {
+		return "", false
+	}
+
+	return joinDescription(parts), true
+}
+
+func describeRegex(re *syntax.Regexp) ([]descriptionPart, bool) {
+	switch re.Op {
+	case syntax.OpEmptyMatch:
+		return nil, true
+
+	case syntax.OpLiteral:
+		literal := string(re.Rune)
+		if literal == " " {
+			literal = "a space"
+		}
+		return []descriptionPart{{text: literal}}, true
+
+	case syntax.OpCharClass:
+		part, ok := describeCharClass(re.Rune, 1, 1)
+		return []descriptionPart{part}, ok
+
+	case syntax.OpRepeat:
+		if re.Sub[0].Op == syntax.OpCharClass && re.Max >= re.Min {
+			part, ok := describeCharClass(re.Sub[0].Rune, re.Min, re.Max)
+			return []descriptionPart{part}, ok
+		}
+
+	case syntax.OpCapture:
+		return describeRegex(re.Sub[0])
+
+	case syntax.OpQuest:
+		parts, ok := describeRegex(re.Sub[0])
+		if !ok || len(parts) == 0 {
+			return nil, false
+		}
+		return []descriptionPart{{text: joinDescription(parts), optional: true}}, true
+
+	case syntax.OpConcat:
+		var parts []descriptionPart
+		for _, sub := range re.Sub {
+			subParts, ok := describeRegex(sub)
+			if !ok {
+				return nil, false
+			}
+
+			for _, part := range subParts {
+				// Adjacent parts with the same unit are merged,
+				// so that \d\d is described as 2 digits.
+				if n := len(parts); n > 0 && part.unit != "" && part.unit == parts[n-1].unit {
+					parts[n-1].min += part.min
+					parts[n-1].max += part.max
+					continue
+				}
+				parts = append(parts, part)
+			}
+		}
+		return parts, true
+	}
+
+	return nil, false
+}
+
+// describeCharClass describes a character class repeated between min
+// and max times. Like masks, classes that only allow some digits or
+// letters are described as digits or letters. Other classes that
+// are not repeated, such as [ -], list their characters.
+func describeCharClass(ranges []rune, min, max int) (descriptionPart, bool) {
+	if char, ok := charClassMask(ranges); ok {
+		return descriptionPart{unit: descriptionUnits[char], min: min, max: max}, true
+	}
+
+	if min != 1 || max != 1 {
+		return descriptionPart{}, false
+	}
+
+	var chars []string
+	for i := 0; i+1 < len(ranges); i += 2 {
+		if ranges[i] != ranges[i+1] {
+			return descriptionPart{}, false
+		}
+
+		char := string(ranges[i])
+		if char == " " {
+			char = "a space"
+		}
+		chars = append(chars, char)
+	}
+
+	return descriptionPart{text: strings.Join(chars, " or ")}, true
+}
+
+// String returns the description of the part, such as 3 to 4 digits.
+func (p descriptionPart) String() string {
+	if p.unit == "" {
+		return p.text
+	}
+
+	count := strconv.Itoa(p.min)
+	if p.max != p.min {
+		count += " to " + strconv.Itoa(p.max)
+	}
+
+	unit := p.unit
+	if p.max > 1 {
+		unit = strings.Replace(strings.Replace(unit, "letter", "letters", 1), "digit", "digits", 1)
+	}
+
+	return count + " " + unit
+}
+
+// joinDescription joins the parts of a description, so that
+// the last required part is joined using "and" and optional
+// parts are described as following the parts before them.
+func joinDescription(parts []descriptionPart) string {
+	var description strings.Builder
+
+	for i, part := range parts {
+		switch {
+		case i == 0 && part.optional:
+			description.WriteString("optionally ")
+		case i == 0:
+		case part.optional:
+			description.WriteString(", optionally followed by ")
+		case i == len(parts)-1:
+			description.WriteString(" and ")
+		default:
+			description.WriteString(", ")
+		}
+
+		description.WriteString(part.String())
+	}
+
+	return description.String()
+}
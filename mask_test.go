@@ -0,0 +1,163 @@
+package libaddress
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPostCodeMask(t *testing.T) {
+
+	tests := []struct {
+		Country      string
+		ExpectedMask string
+		ExpectedHint string
+	}{
+		{
+			Country:      "AU",
+			ExpectedMask: "9999",
+			ExpectedHint: "e.g. 2060, 3171",
+		},
+		{
+			Country:      "PL",
+			ExpectedMask: "99-999",
+			ExpectedHint: "e.g. 00-950, 05-470",
+		},
+		{
+			Country:      "AD", // Literal digits are escaped
+			ExpectedMask: `\AD9\09`,
+			ExpectedHint: "e.g. AD100, AD501",
+		},
+		{
+			Country:      "GI",
+			ExpectedMask: `GX\1\1 \1\A\A`,
+			ExpectedHint: "e.g. GX11 1AA",
+		},
+		{
+			Country:      "ca", // The space is optional
+			ExpectedMask: "",
+			ExpectedHint: "e.g. H3Z 2Y7, V8X 3X4",
+		},
+		{
+			Country:      "IL", // The last 2 digits are optional
+			ExpectedMask: "",
+			ExpectedHint: "e.g. 9614303",
+		},
+		{
+			Country:      "CR", // Post codes with different lengths
+			ExpectedMask: "",
+			ExpectedHint: "e.g. 1000, 2010",
+		},
+		{
+			Country:      "US", // ZIP+4 codes are optional
+			ExpectedMask: "",
+			ExpectedHint: "e.g. 95014, 22162-1010",
+		},
+		{
+			Country:      "GB",
+			ExpectedMask: "",
+			ExpectedHint: "e.g. EC1Y 8SY, GIR 0AA",
+		},
+		{
+			Country:      "AO",
+			ExpectedMask: "",
+			ExpectedHint: "",
+		},
+	}
+
+	for i, testCase := range tests {
+		if mask := PostCodeMask(testCase.Country); mask != testCase.ExpectedMask {
+			t.Errorf("Post code mask in test case %d does not match expected mask, got %q", i, mask)
+		}
+
+		if hint := PostCodeHint(testCase.Country); hint != testCase.ExpectedHint {
+			t.Errorf("Post code hint in test case %d does not match expected hint, got %q", i, hint)
+		}
+
+		if mask := GetExternalCountry(strings.ToUpper(testCase.Country)).PostCodeMask; mask != testCase.ExpectedMask {
+			t.Errorf("External country post code mask in test case %d does not match expected mask, got %q", i, mask)
+		}
+	}
+}
+
+func TestPostCodeMaskMatchesExamples(t *testing.T) {
+
+	for cc := range generated {
+		mask := PostCodeMask(cc)
+		if mask == "" {
+			continue
+		}
+
+		re := regexp.MustCompile(`^` + maskRegex(mask) + `$`)
+
+		for _, example := range ExamplePostCodes(cc) {
+			if !re.MatchString(example) {
+				t.Errorf("Example post code %s in %s does not match the mask %s", example, cc, mask)
+			}
+		}
+	}
+}
+
+func TestPostCodeHint(t *testing.T) {
+
+	// Without examples, the hint describes the regular expression.
+	tests := []struct {
+		Country  string
+		Expected string
+	}{
+		{Country: "AU", Expected: "4 digits"},
+		{Country: "US", Expected: "5 digits, optionally followed by a space or - and 4 digits"},
+		{Country: "NL", Expected: "4 digits, optionally followed by a space and 2 letters"},
+		{Country: "CA", Expected: "1 letter, 1 digit, 1 letter, optionally followed by a space, 1 digit, 1 letter and 1 digit"},
+		{Country: "MT", Expected: "3 letters, optionally followed by a space and 2 to 4 digits"},
+		{Country: "AI", Expected: "optionally AI- and 2640"},
+		{Country: "AC", Expected: "ASCN 1ZZ"},
+		{Country: "GB", Expected: ""}, // Alternatives are too complex
+		{Country: "CR", Expected: ""},
+		{Country: "AO", Expected: ""},
+	}
+
+	for i, testCase := range tests {
+		data := generated.getCountry(testCase.Country)
+		data.PostCodeRegex.examples = nil
+
+		if hint := postCodeHint(data); hint != testCase.Expected {
+			t.Errorf("Post code hint without examples in test case %d does not match expected hint, got %q", i, hint)
+		}
+	}
+
+	for cc := range generated {
+		data := generated.getCountry(cc)
+		data.PostCodeRegex.examples = nil
+
+		if hint := postCodeHint(data); strings.Contains(hint, `\`) {
+			t.Errorf("Expected the post code hint of %s to not contain mask syntax, got %q", cc, hint)
+		}
+	}
+}
+
+// maskRegex converts a mask back into a regular expression.
+func maskRegex(mask string) string {
+	var re strings.Builder
+	escaped := false
+
+	for _, r := range mask {
+		switch {
+		case escaped:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == maskEscape:
+			escaped = true
+		case r == maskDigit:
+			re.WriteString(`\d`)
+		case r == maskLetter:
+			re.WriteString(`[A-Z]`)
+		case r == maskAlphanumeric:
+			re.WriteString(`[A-Z\d]`)
+		default:
+			re.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	return re.String()
+}
@@ -0,0 +1,170 @@
+package libaddress
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+)
+
+// Normalize cleans up an address so that it can be validated. Whitespace
+// is trimmed and collapsed and all fields are converted to Unicode NFC.
+// The country code is converted to its alpha-2 code, so that AUS and 036
+// become AU (see NormalizeCountryCode). Administrative areas, localities
+// and dependent localities are resolved to the IDs expected by Validate
+// from their IDs, names, postal keys or latinized names in any language,
+// ignoring case. Fields that the country requires to be in upper case
+// are uppercased.
+//
+// If the name of a subdivision matches more than one subdivision, it is
+// left as is and ValidationErrors is returned with a FieldError for the
+// subdivision. Its Code is CodeAmbiguous, its Suggestions are the IDs
+// of the matching subdivisions and its Err is an ErrAmbiguousSubdivision.
+// If the country does not exist, the FieldError is for the country, as
+// returned by Validate, and its Err is ErrInvalidCountryCode.
+func Normalize(address Address) (Address, error) {
+	address = normalizeText(address)
+
+	if !generated.hasCountry(address.Country) {
+		return address, ValidationErrors{{
+			Field: Country,
+			Code:  CodeInvalidValue,
+			Value: address.Country,
+			Err:   ErrInvalidCountryCode,
+		}}
+	}
+
+	var errs ValidationErrors
+	data := generated.getCountry(address.Country)
+	resolved := map[Field]bool{}
+
+	if len(data.AdministrativeAreas) > 0 {
+		fields := []Field{AdministrativeArea, Locality, DependentLocality}
+		values := []string{address.AdministrativeArea, address.Locality, address.DependentLocality}
+
+		for depth, field := range fields {
+			if values[depth] == "" {
+				continue
+			}
+
+			query := make([]string, len(values))
+			copy(query, values[:depth+1])
+
+			paths := findSubdivisions(data, query[0], query[1], query[2], matchesFolded)
+			ids := subdivisionIDs(paths, field)
+
+			switch {
+			case len(ids) == 1:
+				values[depth] = ids[0]
+				resolved[field] = true
+			case len(ids) > 1:
+				errs = append(errs, &FieldError{
+					Field:       field,
+					Code:        CodeAmbiguous,
+					Value:       values[depth],
+					Suggestions: ids,
+					Err: ErrAmbiguousSubdivision{
+						Field:      field,
+						Value:      values[depth],
+						Candidates: ids,
+					},
+				})
+			}
+		}
+
+		address.AdministrativeArea = values[0]
+		address.Locality = values[1]
+		address.DependentLocality = values[2]
+	}
+
+	// Subdivision IDs are left as is, as they
+	// need to match the data exactly.
+	caser := upperCaser(data.DefaultLanguage)
+	for field := range data.Upper {
+		if resolved[field] {
+			continue
+		}
+
+		if field == StreetAddress {
+			for i, line := range address.StreetAddress {
+				address.StreetAddress[i] = caser.String(line)
+			}
+			continue
+		}
+
+		setField(&address, field, caser.String(getField(address, field)))
+	}
+
+	return address, errs.ErrorOrNil()
+}
+
+func normalizeText(address Address) Address {
+	normalized := Address{
+		Country:            countryCode(normalizeSpace(address.Country)),
+		Name:               normalizeSpace(address.Name),
+		Organization:       normalizeSpace(address.Organization),
+		DependentLocality:  normalizeSpace(address.DependentLocality),
+		Locality:           normalizeSpace(address.Locality),
+		AdministrativeArea: normalizeSpace(address.AdministrativeArea),
+		PostCode:           normalizeSpace(address.PostCode),
+		SortingCode:        normalizeSpace(address.SortingCode),
+	}
+
+	for _, line := range address.StreetAddress {
+		if line = normalizeSpace(line); line != "" {
+			normalized.StreetAddress = append(normalized.StreetAddress, line)
+		}
+	}
+
+	return normalized
+}
+
+func normalizeSpace(s string) string {
+	return norm.NFC.String(strings.Join(strings.Fields(s), " "))
+}
+
+// matchesFolded checks if the value matches any of the keys
+// ignoring case and differences in Unicode normalization.
+func matchesFolded(value string, keys ...string) bool {
+	folded := foldKey(value)
+
+	for _, key := range keys {
+		if key != "" && foldKey(key) == folded {
+			return true
+		}
+	}
+
+	return false
+}
+
+func foldKey(s string) string {
+	return cases.Fold().String(normalizeSpace(s))
+}
+
+// subdivisionIDs returns the distinct IDs of the subdivisions
+// at the level of the field in the paths.
+func subdivisionIDs(paths []SubdivisionPath, field Field) []string {
+	seen := map[string]struct{}{}
+	var ids []string
+
+	for _, path := range paths {
+		var id string
+
+		switch field {
+		case AdministrativeArea:
+			id = path.AdministrativeArea
+		case Locality:
+			id = path.Locality
+		case DependentLocality:
+			id = path.DependentLocality
+		}
+
+		if _, ok := seen[id]; id == "" || ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
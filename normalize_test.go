@@ -0,0 +1,202 @@
+package libaddress
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+
+	tests := []struct {
+		Address  Address
+		Expected Address
+	}{
+		{
+			Address: New(
+				WithName("  John   Smith "),
+				WithStreetAddress([]string{
+					" 525  Collins Street ",
+					"",
+				}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea(" victoria"),
+				WithPostCode("3000"),
+				WithCountry("au"),
+			),
+			Expected: Address{
+				Country: "AU",
+				Name:    "John Smith",
+				StreetAddress: []string{
+					"525 Collins Street",
+				},
+				Locality:           "MELBOURNE",
+				AdministrativeArea: "VIC",
+				PostCode:           "3000",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"No.1 Jianguomenwai Avenue",
+				}),
+				WithDependentLocality("linxiang qu"),
+				WithLocality("Lincang Shi"),
+				WithAdministrativeArea("Yunnan Sheng"),
+				WithPostCode("677000"),
+				WithCountry("CN"),
+			),
+			Expected: Address{
+				Country: "CN",
+				StreetAddress: []string{
+					"No.1 Jianguomenwai Avenue",
+				},
+				DependentLocality:  "临翔区",
+				Locality:           "临沧市",
+				AdministrativeArea: "53",
+				PostCode:           "677000",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"10 Downing Street",
+				}),
+				WithLocality("London"),
+				WithPostCode("sw1a 2aa"),
+				WithCountry("GB"),
+			),
+			Expected: Address{
+				Country: "GB",
+				StreetAddress: []string{
+					"10 Downing Street",
+				},
+				Locality: "LONDON",
+				PostCode: "SW1A 2AA",
+			},
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{
+					"Calle de Alcalá 1",
+				}),
+				WithLocality("Madrid"),
+				WithAdministrativeArea("Madrid"),
+				WithPostCode("28014"),
+				WithCountry("ES"),
+			),
+			Expected: Address{
+				Country: "ES",
+				StreetAddress: []string{
+					"Calle de Alcalá 1",
+				},
+				Locality:           "MADRID",
+				AdministrativeArea: "M",
+				PostCode:           "28014",
+			},
+		},
+		{
+			Address: Address{
+				Country:            "AUS",
+				StreetAddress:      []string{"525 Collins Street"},
+				Locality:           "Melbourne",
+				AdministrativeArea: "victoria",
+				PostCode:           "3000",
+			},
+			Expected: Address{
+				Country:            "AU",
+				StreetAddress:      []string{"525 Collins Street"},
+				Locality:           "MELBOURNE",
+				AdministrativeArea: "VIC",
+				PostCode:           "3000",
+			},
+		},
+	}
+
+	for i, testCase := range tests {
+		address, err := Normalize(testCase.Address)
+
+		if err != nil {
+			t.Errorf("Unexpected error normalizing address in test case %d: %s", i, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(address, testCase.Expected) {
+			t.Errorf("Normalized address in test case %d does not match expected address, got %+v", i, address)
+		}
+
+		if err := Validate(address); err != nil {
+			t.Errorf("Normalized address in test case %d is not valid: %s", i, err)
+		}
+	}
+}
+
+func TestNormalizeInvalidCountry(t *testing.T) {
+
+	_, err := Normalize(New(
+		WithLocality("Melbourne"),
+		WithCountry("XX"),
+	))
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("Expected ValidationErrors with one error, got %v", err)
+	}
+
+	expected := &FieldError{Field: Country, Code: CodeInvalidValue, Value: "XX", Err: ErrInvalidCountryCode}
+	if !reflect.DeepEqual(errs[0], expected) {
+		t.Errorf("Expected an invalid country error, got %+v", errs[0])
+	}
+
+	if !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("Expected the error to be an ErrInvalidCountryCode, got %s", err)
+	}
+}
+
+func TestNormalizeAmbiguous(t *testing.T) {
+
+	address, err := Normalize(New(
+		WithLocality("Suzhou Shi"),
+		WithCountry("CN"),
+	))
+
+	if err == nil {
+		t.Fatalf("Expected an error when normalizing an ambiguous locality")
+	}
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("Expected ValidationErrors with one error, got %s", err)
+	}
+
+	if errs[0].Field != Locality || errs[0].Code != CodeAmbiguous || errs[0].Value != "Suzhou Shi" || len(errs[0].Suggestions) != 2 {
+		t.Errorf("Expected an ambiguous locality with 2 suggestions, got %+v", errs[0])
+	}
+
+	var ambiguous ErrAmbiguousSubdivision
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("Expected an ErrAmbiguousSubdivision, got %s", err)
+	}
+
+	if ambiguous.Field != Locality || len(ambiguous.Candidates) != 2 {
+		t.Errorf("Expected 2 candidates for the locality, got %v", ambiguous.Candidates)
+	}
+
+	if address.Locality != "Suzhou Shi" {
+		t.Errorf("Expected ambiguous locality to be left as is, got %s", address.Locality)
+	}
+
+	address, err = Normalize(New(
+		WithLocality("Suzhou Shi"),
+		WithAdministrativeArea("jiangsu sheng"),
+		WithCountry("CN"),
+	))
+
+	if err != nil {
+		t.Fatalf("Unexpected error normalizing address: %s", err)
+	}
+
+	if address.AdministrativeArea != "32" || address.Locality != "苏州市" {
+		t.Errorf("Expected locality to be resolved to 苏州市 in 32, got %s in %s", address.Locality, address.AdministrativeArea)
+	}
+}
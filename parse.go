@@ -0,0 +1,617 @@
+package libaddress
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Confidence indicates how confident the parser is that a value
+// was assigned to the correct address field.
+type Confidence int
+
+const (
+	// ConfidenceLow indicates that the value was assigned to
+	// the field based on its position in the text alone.
+	ConfidenceLow Confidence = iota + 1
+
+	// ConfidenceMedium indicates that the value was assigned
+	// to the field based on its position and the separators
+	// used by the address format of the country.
+	ConfidenceMedium
+
+	// ConfidenceHigh indicates that the value matched the post
+	// code regular expression or a known subdivision of the
+	// country.
+	ConfidenceHigh
+)
+
+// ParseResult contains an address parsed from free text and the
+// confidence of each field that was parsed.
+type ParseResult struct {
+	Address    Address
+	Confidence map[Field]Confidence
+}
+
+// Parse splits a multi-line address into its fields using the address
+// format of the country. Administrative areas, localities and dependent
+// localities are resolved to their IDs if the country has a list of
+// subdivisions. The parsed address should be validated before it is
+// used.
+func Parse(cc string, text string) (Address, error) {
+	result, err := ParseWithConfidence(cc, text)
+	return result.Address, err
+}
+
+// ParseWithConfidence parses a multi-line address like Parse, and
+// also returns the confidence of each field that was parsed.
+func ParseWithConfidence(cc string, text string) (ParseResult, error) {
+	cc = countryCode(cc)
+
+	result := ParseResult{
+		Address: Address{
+			Country: cc,
+		},
+		Confidence: map[Field]Confidence{},
+	}
+
+	if !generated.hasCountry(cc) {
+		return result, ErrInvalidCountryCode
+	}
+
+	p := generated.parser(cc)
+	p.parse(splitLines(text), &result)
+	p.resolveSubdivisions(&result)
+
+	return result, nil
+}
+
+func splitLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.Replace(text, "\r", "\n", -1), "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// Scores used to align lines of text with lines of the address format.
+// Name and organization lines are preferred over street address lines
+// when they do not contain any digits.
+const (
+	scoreNoMatch         = math.MinInt32
+	scoreEmptyStreet     = -10
+	scoreStreetDigits    = 1
+	scoreOrganization    = 2
+	scoreName            = 3
+	scoreLiteral         = 3
+	scoreConfidenceScale = 2
+)
+
+type parser struct {
+	country country
+	lines   [][]formatToken
+
+	// streetLine is the index of the format line
+	// containing the street address.
+	streetLine int
+
+	patterns map[Field]string
+	known    map[Field]map[string]struct{}
+
+	// compiled caches the regular expressions compiled for each
+	// combination of format tokens, keyed by their pattern. It is
+	// shared by all parses in the country.
+	compiled sync.Map
+}
+
+type lineMatch struct {
+	score      int
+	values     map[Field]string
+	confidence map[Field]Confidence
+}
+
+// parsers caches the parser of each country, as building the patterns
+// and compiling the regular expressions of countries with many
+// subdivisions, such as CN, is slow. A parser is built the first time
+// an address in the country is parsed.
+var parsers sync.Map
+
+// parser returns the parser of a country,
+// which must exist in the data.
+func (d data) parser(cc string) *parser {
+	if p, ok := parsers.Load(cc); ok {
+		return p.(*parser)
+	}
+
+	p, _ := parsers.LoadOrStore(cc, newParser(d.getCountry(cc)))
+
+	return p.(*parser)
+}
+
+func newParser(c country) *parser {
+	p := &parser{
+		country:    c,
+		streetLine: -1,
+		patterns:   map[Field]string{},
+		known:      map[Field]map[string]struct{}{},
+	}
+
+	for _, tokens := range parseFormat(c.Format) {
+		if len(tokens) == 0 {
+			continue
+		}
+
+		for _, token := range tokens {
+			if token.field == StreetAddress && p.streetLine == -1 {
+				p.streetLine = len(p.lines)
+			}
+		}
+
+		p.lines = append(p.lines, tokens)
+	}
+
+	if c.PostCodeRegex.regex != "" {
+		p.patterns[PostCode] = c.PostCodeRegex.regex
+	}
+
+	var areas, localities, dependentLocalities []string
+	for _, adminAreas := range c.AdministrativeAreas {
+		for _, area := range adminAreas {
+			areas = append(areas, area.ID, area.Name, area.PostalKey)
+			for _, locality := range area.Localities {
+				localities = append(localities, locality.ID, locality.Name)
+				for _, dl := range locality.DependentLocalities {
+					dependentLocalities = append(dependentLocalities, dl.ID, dl.Name)
+				}
+			}
+		}
+	}
+
+	for field, values := range map[Field][]string{
+		AdministrativeArea: areas,
+		Locality:           localities,
+		DependentLocality:  dependentLocalities,
+	} {
+		if len(values) == 0 {
+			continue
+		}
+
+		p.patterns[field] = alternation(values)
+		p.known[field] = map[string]struct{}{}
+
+		for _, value := range values {
+			p.known[field][strings.ToLower(value)] = struct{}{}
+		}
+	}
+
+	return p
+}
+
+// alternation creates a regular expression matching any of the values.
+// Longer values are matched first.
+func alternation(values []string) string {
+	seen := map[string]struct{}{}
+	var unique []string
+
+	for _, value := range values {
+		if _, ok := seen[value]; value == "" || ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		unique = append(unique, regexp.QuoteMeta(value))
+	}
+
+	sort.Slice(unique, func(i, j int) bool {
+		return len(unique[i]) > len(unique[j])
+	})
+
+	return strings.Join(unique, "|")
+}
+
+// parse aligns the lines of text with the lines of the address format.
+// Each format line is matched with at most one line of text, and the
+// lines that are not matched by the lines before and after the street
+// address in the format become the street address.
+func (p *parser) parse(input []string, result *ParseResult) {
+	input = p.removeCountryName(input)
+
+	n, m := len(input), len(p.lines)
+	matches := make([][]*lineMatch, n)
+	for i := range matches {
+		matches[i] = make([]*lineMatch, m)
+	}
+
+	match := func(i, j int) *lineMatch {
+		if matches[i][j] == nil {
+			matches[i][j] = p.matchLine(p.lines[j], input[i])
+		}
+		return matches[i][j]
+	}
+
+	type step struct {
+		score int
+		next  int // the next input line
+		match bool
+	}
+
+	memo := make([][]*step, n+1)
+	for i := range memo {
+		memo[i] = make([]*step, m+1)
+	}
+
+	var solve func(i, j int) int
+	solve = func(i, j int) int {
+		if j == m {
+			if i == n {
+				return 0
+			}
+			return scoreNoMatch
+		}
+
+		if memo[i][j] != nil {
+			return memo[i][j].score
+		}
+
+		best := &step{score: scoreNoMatch}
+
+		if j == p.streetLine {
+			score := scoreEmptyStreet
+			for k := i; k <= n; k++ {
+				if k > i {
+					if k == i+1 {
+						score = 0
+					}
+					if strings.IndexFunc(input[k-1], unicode.IsDigit) != -1 {
+						score += scoreStreetDigits
+					}
+				}
+
+				if rest := solve(k, j+1); rest != scoreNoMatch && score+rest > best.score {
+					best = &step{score: score + rest, next: k}
+				}
+			}
+		} else {
+			if rest := solve(i, j+1); rest != scoreNoMatch {
+				best = &step{score: rest, next: i}
+			}
+
+			if i < n {
+				if lm := match(i, j); lm.score != scoreNoMatch {
+					if rest := solve(i+1, j+1); rest != scoreNoMatch && lm.score+rest > best.score {
+						best = &step{score: lm.score + rest, next: i + 1, match: true}
+					}
+				}
+			}
+		}
+
+		memo[i][j] = best
+		return best.score
+	}
+
+	if solve(0, 0) == scoreNoMatch {
+		return
+	}
+
+	for i, j := 0, 0; j < m; j++ {
+		s := memo[i][j]
+
+		if j == p.streetLine {
+			if s.next > i {
+				result.Address.StreetAddress = input[i:s.next]
+				result.Confidence[StreetAddress] = ConfidenceMedium
+			}
+		} else if s.match {
+			lm := match(i, j)
+			for field, value := range lm.values {
+				setField(&result.Address, field, value)
+				result.Confidence[field] = lm.confidence[field]
+			}
+		}
+
+		i = s.next
+	}
+}
+
+// removeCountryName removes the last line if it only contains the
+// name or code of the country.
+func (p *parser) removeCountryName(input []string) []string {
+	if len(input) == 0 {
+		return input
+	}
+
+	last := input[len(input)-1]
+	names := []string{p.country.ID, p.country.Name}
+
+	if region, err := language.ParseRegion(p.country.ID); err == nil {
+		names = append(names, display.English.Regions().Name(region))
+	}
+
+	for _, name := range names {
+		if name != "" && strings.EqualFold(last, name) {
+			return input[:len(input)-1]
+		}
+	}
+
+	return input
+}
+
+func (p *parser) matchLine(tokens []formatToken, line string) *lineMatch {
+	noMatch := &lineMatch{score: scoreNoMatch}
+
+	var fields []Field
+	var literals []string
+
+	for _, token := range tokens {
+		if token.field == 0 {
+			literals = append(literals, strings.TrimSpace(token.literal))
+		} else {
+			fields = append(fields, token.field)
+		}
+	}
+
+	// Literal lines such as GUERNSEY or ÅLAND
+	if len(fields) == 0 {
+		if strings.EqualFold(strings.Join(literals, " "), line) {
+			return &lineMatch{score: scoreLiteral}
+		}
+		return noMatch
+	}
+
+	if len(fields) == 1 && (fields[0] == Name || fields[0] == Organization) {
+		hasDigits := strings.IndexFunc(line, unicode.IsDigit) != -1
+		lm := &lineMatch{
+			values:     map[Field]string{fields[0]: line},
+			confidence: map[Field]Confidence{fields[0]: ConfidenceLow},
+		}
+
+		switch {
+		case fields[0] == Name && !hasDigits:
+			lm.score = scoreName
+		case fields[0] == Organization && !hasDigits:
+			lm.score = scoreOrganization
+		case fields[0] == Organization:
+			lm.score = 0
+		default:
+			return noMatch
+		}
+
+		return lm
+	}
+
+	best := noMatch
+
+	// Try every combination of the fields on the line, as some
+	// of them may have been left out. Subdivisions are matched
+	// against the list of subdivisions of the country first, and
+	// only treated as free text if that fails.
+	for _, free := range []bool{false, true} {
+		for mask := 1; mask < 1<<uint(len(fields)); mask++ {
+			lm := p.matchFields(tokens, mask, free, line)
+			if lm != nil && lm.score > best.score {
+				best = lm
+			}
+		}
+
+		if best.score != scoreNoMatch {
+			break
+		}
+	}
+
+	return best
+}
+
+// matchFields matches a line of text against the fields of a format
+// line selected by mask. Literals between fields are optional. If free
+// is true, subdivisions can be any text.
+func (p *parser) matchFields(tokens []formatToken, mask int, free bool, line string) *lineMatch {
+	var expr strings.Builder
+	var fields []Field
+
+	expr.WriteString(`(?i)^\s*`)
+
+	index := 0
+	for i, token := range tokens {
+		if token.field == 0 {
+			continue
+		}
+
+		selected := mask&(1<<uint(index)) != 0
+		index++
+
+		if !selected {
+			continue
+		}
+
+		if i > 0 && tokens[i-1].field == 0 && (len(fields) > 0 || i == 1) {
+			expr.WriteString(literalPattern(tokens[i-1].literal))
+		}
+
+		pattern, ok := p.patterns[token.field]
+		if _, isSubdivision := p.known[token.field]; !ok || (free && isSubdivision) {
+			pattern = `.+?`
+		}
+
+		expr.WriteString(`(?P<f` + strconv.Itoa(int(token.field)) + `>` + pattern + `)`)
+		fields = append(fields, token.field)
+	}
+
+	if n := len(tokens); n > 1 && tokens[n-1].field == 0 && mask&(1<<uint(index-1)) != 0 {
+		expr.WriteString(literalPattern(tokens[n-1].literal))
+	}
+
+	expr.WriteString(`\s*$`)
+
+	var re *regexp.Regexp
+	if cached, ok := p.compiled.Load(expr.String()); ok {
+		re = cached.(*regexp.Regexp)
+	} else {
+		compiled, err := regexp.Compile(expr.String())
+		if err != nil {
+			return nil
+		}
+
+		cached, _ = p.compiled.LoadOrStore(expr.String(), compiled)
+		re = cached.(*regexp.Regexp)
+	}
+
+	submatches := re.FindStringSubmatch(line)
+	if submatches == nil {
+		return nil
+	}
+
+	lm := &lineMatch{
+		values:     map[Field]string{},
+		confidence: map[Field]Confidence{},
+	}
+
+	for i, name := range re.SubexpNames() {
+		if !strings.HasPrefix(name, "f") {
+			continue
+		}
+
+		id, _ := strconv.Atoi(name[1:])
+		field := Field(id)
+		value := strings.TrimSpace(submatches[i])
+
+		if value == "" {
+			return nil
+		}
+
+		confidence := ConfidenceMedium
+		if known, ok := p.known[field]; ok {
+			if _, ok := known[strings.ToLower(value)]; ok {
+				confidence = ConfidenceHigh
+			}
+		} else if _, ok := p.patterns[field]; ok {
+			confidence = ConfidenceHigh
+		}
+
+		lm.values[field] = value
+		lm.confidence[field] = confidence
+		lm.score += int(confidence) * scoreConfidenceScale
+	}
+
+	return lm
+}
+
+func literalPattern(literal string) string {
+	if literal = strings.TrimSpace(literal); literal == "" {
+		return `\s*`
+	}
+	return `\s*(?:` + regexp.QuoteMeta(literal) + `)?\s*`
+}
+
+// resolveSubdivisions replaces the names of subdivisions with their
+// IDs. If the administrative area was not parsed, it is inferred from
+// the locality if possible. Subdivisions that cannot be resolved are
+// left as is with a low confidence.
+func (p *parser) resolveSubdivisions(result *ParseResult) {
+	address := &result.Address
+
+	if len(p.country.AdministrativeAreas) == 0 {
+		return
+	}
+
+	values := []string{address.AdministrativeArea, address.Locality, address.DependentLocality}
+	fields := []Field{AdministrativeArea, Locality, DependentLocality}
+
+	for depth := len(values); depth > 0; depth-- {
+		if values[depth-1] == "" {
+			continue
+		}
+
+		candidates := findSubdivisions(p.country, values[0], values[1], values[2], matchesSubdivision)
+
+		if len(candidates) == 1 {
+			resolved := candidates[0]
+
+			if address.AdministrativeArea == "" {
+				result.Confidence[AdministrativeArea] = ConfidenceMedium
+			}
+
+			address.AdministrativeArea = resolved.AdministrativeArea
+			if resolved.Locality != "" {
+				address.Locality = resolved.Locality
+			}
+			if resolved.DependentLocality != "" {
+				address.DependentLocality = resolved.DependentLocality
+			}
+		}
+
+		if len(candidates) > 0 {
+			break
+		}
+
+		// The subdivision at this depth is not known,
+		// so try to resolve its parents.
+		if _, ok := result.Confidence[fields[depth-1]]; ok {
+			result.Confidence[fields[depth-1]] = ConfidenceLow
+		}
+		values[depth-1] = ""
+	}
+}
+
+// findSubdivisions returns the distinct paths of subdivisions in any
+// language matching the administrative area, locality and dependent
+// locality. Empty values match any subdivision, but a path only extends
+// to the deepest non-empty value that has a list of subdivisions.
+func findSubdivisions(c country, area, locality, dependentLocality string, match func(value string, keys ...string) bool) []SubdivisionPath {
+	seen := map[SubdivisionPath]struct{}{}
+	var paths []SubdivisionPath
+
+	add := func(path SubdivisionPath) {
+		if _, ok := seen[path]; !ok {
+			seen[path] = struct{}{}
+			paths = append(paths, path)
+		}
+	}
+
+	for _, areas := range c.AdministrativeAreas {
+		for _, a := range areas {
+			if area != "" && !match(area, a.ID, a.Name, a.PostalKey) {
+				continue
+			}
+
+			if (locality == "" && dependentLocality == "") || len(a.Localities) == 0 {
+				add(SubdivisionPath{AdministrativeArea: a.ID})
+				continue
+			}
+
+			for _, l := range a.Localities {
+				if locality != "" && !match(locality, l.ID, l.Name) {
+					continue
+				}
+
+				if dependentLocality == "" || len(l.DependentLocalities) == 0 {
+					add(SubdivisionPath{a.ID, l.ID, ""})
+					continue
+				}
+
+				for _, dl := range l.DependentLocalities {
+					if match(dependentLocality, dl.ID, dl.Name) {
+						add(SubdivisionPath{a.ID, l.ID, dl.ID})
+					}
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
+func matchesSubdivision(value string, keys ...string) bool {
+	for _, key := range keys {
+		if key != "" && strings.EqualFold(value, key) {
+			return true
+		}
+	}
+	return false
+}
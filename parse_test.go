@@ -0,0 +1,213 @@
+package libaddress
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+
+	tests := []struct {
+		Country  string
+		Text     string
+		Expected Address
+	}{
+		{
+			Country: "AU",
+			Text: `Company Pty Ltd
+				John Smith
+				Level 1
+				525 Collins Street
+				Melbourne VIC 3000
+				Australia`,
+			Expected: Address{
+				Country:      "AU",
+				Name:         "John Smith",
+				Organization: "Company Pty Ltd",
+				StreetAddress: []string{
+					"Level 1",
+					"525 Collins Street",
+				},
+				Locality:           "Melbourne",
+				AdministrativeArea: "VIC",
+				PostCode:           "3000",
+			},
+		},
+		{
+			Country: "au",
+			Text: `John Smith
+				525 Collins Street
+				Mount Isa Queensland 4825`,
+			Expected: Address{
+				Country: "AU",
+				Name:    "John Smith",
+				StreetAddress: []string{
+					"525 Collins Street",
+				},
+				Locality:           "Mount Isa",
+				AdministrativeArea: "QLD",
+				PostCode:           "4825",
+			},
+		},
+		{
+			Country: "US",
+			Text: `1600 Amphitheatre Parkway
+				Mountain View, CA 94043`,
+			Expected: Address{
+				Country: "US",
+				StreetAddress: []string{
+					"1600 Amphitheatre Parkway",
+				},
+				Locality:           "Mountain View",
+				AdministrativeArea: "CA",
+				PostCode:           "94043",
+			},
+		},
+		{
+			Country: "GB",
+			Text: `Prime Minister
+				10 Downing Street
+				London
+				SW1A 2AA
+				United Kingdom`,
+			Expected: Address{
+				Country: "GB",
+				Name:    "Prime Minister",
+				StreetAddress: []string{
+					"10 Downing Street",
+				},
+				Locality: "London",
+				PostCode: "SW1A 2AA",
+			},
+		},
+		{
+			Country: "JP",
+			Text: `〒100-0005
+				東京都
+				千代田区丸の内1-1-1
+				山田太郎`,
+			Expected: Address{
+				Country: "JP",
+				Name:    "山田太郎",
+				StreetAddress: []string{
+					"千代田区丸の内1-1-1",
+				},
+				AdministrativeArea: "13",
+				PostCode:           "100-0005",
+			},
+		},
+		{
+			Country: "KR",
+			Text: `경상북도 포항시북구
+				장량로 17번길
+				37554`,
+			Expected: Address{
+				Country: "KR",
+				StreetAddress: []string{
+					"장량로 17번길",
+				},
+				DependentLocality:  "북구",
+				Locality:           "포항시",
+				AdministrativeArea: "47",
+				PostCode:           "37554",
+			},
+		},
+		{
+			Country: "CN",
+			Text: `677000
+				云南省临沧市临翔区
+				No.1 Jianguomenwai Avenue`,
+			Expected: Address{
+				Country: "CN",
+				StreetAddress: []string{
+					"No.1 Jianguomenwai Avenue",
+				},
+				DependentLocality:  "临翔区",
+				Locality:           "临沧市",
+				AdministrativeArea: "53",
+				PostCode:           "677000",
+			},
+		},
+	}
+
+	for i, testCase := range tests {
+		address, err := Parse(testCase.Country, testCase.Text)
+
+		if err != nil {
+			t.Errorf("Unexpected error parsing address in test case %d: %s", i, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(address, testCase.Expected) {
+			t.Errorf("Parsed address in test case %d does not match expected address, got %+v", i, address)
+		}
+
+		if err := Validate(address); err != nil {
+			t.Errorf("Parsed address in test case %d is not valid: %s", i, err)
+		}
+	}
+
+	if _, err := Parse("XX", "525 Collins Street"); err != ErrInvalidCountryCode {
+		t.Errorf("Expected ErrInvalidCountryCode when parsing an address with an invalid country, got %v", err)
+	}
+}
+
+func TestParseConcurrently(t *testing.T) {
+
+	text := "677000\n云南省临沧市临翔区\nNo.1 Jianguomenwai Avenue"
+
+	expected, err := Parse("CN", text)
+	if err != nil {
+		t.Fatalf("Unexpected error parsing address: %s", err)
+	}
+
+	// Parsers are shared by all parses in a country,
+	// so they must be safe to use concurrently.
+	var wg sync.WaitGroup
+	results := make([]Address, 8)
+
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = Parse("CN", text)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i, result := range results {
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("Address parsed concurrently in goroutine %d does not match expected address, got %v", i, result)
+		}
+	}
+
+	if generated.parser("CN") != generated.parser("CN") {
+		t.Errorf("Expected the parser of a country to be cached")
+	}
+}
+
+func TestParseConfidence(t *testing.T) {
+
+	result, err := ParseWithConfidence("AU", "John Smith\n525 Collins Street\nMelbourne Victoria 3000")
+	if err != nil {
+		t.Fatalf("Unexpected error parsing address: %s", err)
+	}
+
+	expected := map[Field]Confidence{
+		Name:               ConfidenceLow,
+		StreetAddress:      ConfidenceMedium,
+		Locality:           ConfidenceMedium,
+		AdministrativeArea: ConfidenceHigh,
+		PostCode:           ConfidenceHigh,
+	}
+
+	if !reflect.DeepEqual(result.Confidence, expected) {
+		t.Errorf("Confidence of parsed address does not match expected confidence, got %v", result.Confidence)
+	}
+
+	if result.Address.AdministrativeArea != "VIC" {
+		t.Errorf("Expected administrative area to be resolved to VIC, got %s", result.Address.AdministrativeArea)
+	}
+}
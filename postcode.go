@@ -0,0 +1,379 @@
+package libaddress
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// postCodePrefixRegex matches the letters at the end of a literal
+// in an address format, such as the FI in FI-%Z or the PR in %C PR %Z.
+var postCodePrefixRegex = regexp.MustCompile(`([A-Z]+)[ \-]?$`)
+
+// compiledRegexes caches the compiled post code regular
+// expressions, keyed by their pattern, so that each is only
+// compiled once.
+var compiledRegexes sync.Map
+
+// compileRegex returns the compiled regular expression for the
+// pattern, compiling and caching it the first time it is used.
+func compileRegex(pattern string) *regexp.Regexp {
+	if regex, ok := compiledRegexes.Load(pattern); ok {
+		return regex.(*regexp.Regexp)
+	}
+
+	regex, _ := compiledRegexes.LoadOrStore(pattern, regexp.MustCompile(pattern))
+
+	return regex.(*regexp.Regexp)
+}
+
+// strictRegexes and subdivisionRegexes cache the anchored forms of the
+// post code regular expressions, keyed by the pattern in the data, so
+// that the anchored patterns are not built on every lookup.
+var strictRegexes, subdivisionRegexes sync.Map
+
+// compileAnchoredRegex returns the compiled anchored form of a pattern
+// from the cache, building and compiling it the first time it is used.
+func compileAnchoredRegex(cache *sync.Map, pattern string, anchor func(string) string) *regexp.Regexp {
+	if regex, ok := cache.Load(pattern); ok {
+		return regex.(*regexp.Regexp)
+	}
+
+	regex, _ := cache.LoadOrStore(pattern, compileRegex(anchor(pattern)))
+
+	return regex.(*regexp.Regexp)
+}
+
+// strictPostCodeRegex compiles a post code regular
+// expression that must match the whole post code.
+func strictPostCodeRegex(regex string) *regexp.Regexp {
+	return compileAnchoredRegex(&strictRegexes, regex, func(regex string) string {
+		return `^(?:` + regex + `)$`
+	})
+}
+
+// postCodePrefixCache caches the post code prefixes of each country, so
+// that the address format is not parsed every time a post code is checked.
+var postCodePrefixCache sync.Map
+
+// postCodePrefixes returns the prefixes that may be written in front of
+// the post codes of a country. These come from the post code prefix of
+// the country and the literal in front of the post code in the address
+// format of the country. Longer prefixes are returned first.
+func postCodePrefixes(c country) []string {
+	if cached, ok := postCodePrefixCache.Load(c.ID); ok {
+		return cached.([]string)
+	}
+
+	cached, _ := postCodePrefixCache.LoadOrStore(c.ID, newPostCodePrefixes(c))
+
+	return cached.([]string)
+}
+
+func newPostCodePrefixes(c country) []string {
+	seen := map[string]struct{}{}
+	var prefixes []string
+
+	add := func(prefix string) {
+		if _, ok := seen[prefix]; prefix != "" && !ok {
+			seen[prefix] = struct{}{}
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	add(strings.TrimRight(c.PostCodePrefix, " -"))
+	add(formatPostCodePrefix(c))
+
+	sort.SliceStable(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+
+	return prefixes
+}
+
+// formatPostCodePrefix returns the prefix written in front of
+// the post code by the address format of a country, if any.
+func formatPostCodePrefix(c country) string {
+	for _, tokens := range parseFormat(c.Format) {
+		for i, token := range tokens {
+			if token.field != PostCode || i == 0 || tokens[i-1].field != 0 {
+				continue
+			}
+
+			if match := postCodePrefixRegex.FindStringSubmatch(tokens[i-1].literal); match != nil {
+				return match[1]
+			}
+		}
+	}
+
+	return ""
+}
+
+// stripPostCodePrefix removes a prefix such as PR or FI- from the
+// front of a post code. The post code is returned as is if it does
+// not have a prefix.
+func stripPostCodePrefix(c country, postCode string) string {
+	for _, prefix := range postCodePrefixes(c) {
+		if len(postCode) <= len(prefix) || !strings.EqualFold(postCode[:len(prefix)], prefix) {
+			continue
+		}
+
+		if stripped := strings.TrimLeft(postCode[len(prefix):], " -"); stripped != "" {
+			return stripped
+		}
+	}
+
+	return postCode
+}
+
+// postCodeCandidates returns the post code as entered, and without
+// its prefix if it has one.
+func postCodeCandidates(c country, postCode string) []string {
+	candidates := []string{postCode}
+
+	if stripped := stripPostCodePrefix(c, postCode); stripped != postCode {
+		candidates = append(candidates, stripped)
+	}
+
+	return candidates
+}
+
+// matchesPostCode checks if the whole post code matches
+// the post code regular expression of a country.
+func matchesPostCode(c country, postCode string) bool {
+	if c.PostCodeRegex.regex == "" {
+		return false
+	}
+
+	return strictPostCodeRegex(c.PostCodeRegex.regex).MatchString(postCode)
+}
+
+// formatPostCode renders a post code with the prefix expected by the
+// postal operator. If the address format already contains the prefix,
+// it is removed from the post code so that it is not repeated.
+func formatPostCode(c country, postCode string) string {
+	if postCode == "" {
+		return ""
+	}
+
+	addPrefix := c.PostCodePrefix != "" && !strings.Contains(c.Format, c.PostCodePrefix+"%Z")
+
+	if addPrefix || formatPostCodePrefix(c) != "" {
+		stripped := stripPostCodePrefix(c, postCode)
+
+		if c.PostCodeRegex.regex == "" || (!matchesPostCode(c, postCode) && matchesPostCode(c, stripped)) {
+			postCode = stripped
+		}
+	}
+
+	if addPrefix {
+		postCode = c.PostCodePrefix + postCode
+	}
+
+	return postCode
+}
+
+// ExamplePostCodes returns example post codes for a country, such as
+// 3000 in Australia, which can be used as placeholders in forms. The IDs
+// of an administrative area, locality and dependent locality can be
+// passed to get examples for the subdivision. If the subdivision does
+// not have examples, the examples of its closest parent are returned.
+func ExamplePostCodes(cc string, subdivisionIDs ...string) []string {
+	return subdivisionExamples(generated.getCountry(countryCode(cc)).PostCodeRegex, subdivisionIDs...)
+}
+
+// subdivisionExamples returns the examples of the deepest subdivision
+// that has examples, falling back to the examples of the country.
+func subdivisionExamples(regex postCodeRegex, subdivisionIDs ...string) []string {
+	examples := regex.examples
+
+	for _, id := range subdivisionIDs {
+		subdivision, ok := regex.subdivisionRegex[id]
+		if !ok {
+			break
+		}
+
+		if len(subdivision.examples) > 0 {
+			examples = subdivision.examples
+		}
+
+		regex = subdivision
+	}
+
+	if len(examples) == 0 {
+		return nil
+	}
+
+	return append([]string(nil), examples...)
+}
+
+// NormalizePostCode converts a post code into the form expected by the
+// postal operator of a country. The post code is uppercased and the
+// spaces and hyphens in it are rearranged to match the example post
+// codes of the country, so that sw1a2aa becomes SW1A 2AA in GB and
+// 1000001 becomes 100-0001 in JP. If the country does not have examples,
+// separators are only inserted where the post code regular expression
+// requires them. Prefixes that are added by the address format of the
+// country, such as FI- in FI-00100, are removed.
+//
+// ErrInvalidCountryCode is returned if the country does not exist, and
+// ErrInvalidPostCode is returned along with the cleaned up post code if
+// it cannot be converted into a valid post code for the country.
+func NormalizePostCode(cc, postCode string) (string, error) {
+	cc = countryCode(cc)
+
+	if !generated.hasCountry(cc) {
+		return postCode, ErrInvalidCountryCode
+	}
+
+	return normalizePostCode(generated.getCountry(cc), postCode)
+}
+
+func normalizePostCode(data country, postCode string) (string, error) {
+	postCode = strings.ToUpper(normalizeSpace(postCode))
+
+	if data.PostCodeRegex.regex == "" {
+		return postCode, nil
+	}
+
+	for _, candidate := range postCodeCandidates(data, postCode) {
+		if canonical, ok := canonicalPostCode(data, candidate); ok {
+			return formatPostCode(data, canonical), nil
+		}
+	}
+
+	return postCode, ErrInvalidPostCode
+}
+
+// canonicalPostCode returns the post code with the separators used by
+// the example post codes of a country. The layouts of the examples are
+// tried from the most to the least common, so that a layout shared by
+// the examples is preferred. If no layout gives a valid post code, the
+// post code is written without separators, unless the post code
+// regular expression requires one.
+func canonicalPostCode(c country, postCode string) (string, bool) {
+	compact := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, postCode)
+
+	if compact == "" {
+		return "", false
+	}
+
+	for _, layout := range postCodeLayouts(c.PostCodeRegex.examples) {
+		if candidate, ok := layout.apply(compact); ok && matchesPostCode(c, candidate) {
+			return candidate, true
+		}
+	}
+
+	if matchesPostCode(c, compact) {
+		return compact, true
+	}
+
+	separators := []string{" ", "-"}
+	if hasLiteralHyphen(c.PostCodeRegex.regex) {
+		separators = []string{"-", " "}
+	}
+
+	for _, separator := range separators {
+		for i := 1; i < len(compact); i++ {
+			if candidate := compact[:i] + separator + compact[i:]; matchesPostCode(c, candidate) {
+				return candidate, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// postCodeLayout contains the separators in a post code. Their
+// positions are counted from the end of the post code, as the start
+// of the post codes of some countries, such as GB, varies in length.
+type postCodeLayout []postCodeSeparator
+
+type postCodeSeparator struct {
+	separator rune
+	fromEnd   int
+}
+
+// postCodeLayouts returns the distinct layouts of the example post
+// codes, sorted from the most to the least common.
+func postCodeLayouts(examples []string) []postCodeLayout {
+	var layouts []postCodeLayout
+	counts := map[string]int{}
+
+	for _, example := range examples {
+		var layout postCodeLayout
+		chars := 0
+
+		for i := len(example) - 1; i >= 0; i-- {
+			if example[i] == ' ' || example[i] == '-' {
+				layout = append(layout, postCodeSeparator{rune(example[i]), chars})
+			} else {
+				chars++
+			}
+		}
+
+		key := fmt.Sprint(layout)
+		if _, ok := counts[key]; !ok {
+			layouts = append(layouts, layout)
+		}
+		counts[key]++
+	}
+
+	sort.SliceStable(layouts, func(i, j int) bool {
+		return counts[fmt.Sprint(layouts[i])] > counts[fmt.Sprint(layouts[j])]
+	})
+
+	return layouts
+}
+
+// apply inserts the separators of the layout into a post
+// code without separators.
+func (l postCodeLayout) apply(compact string) (string, bool) {
+	result := compact
+
+	for _, s := range l {
+		i := len(compact) - s.fromEnd
+		if i <= 0 || i >= len(compact) {
+			return "", false
+		}
+
+		// The separators are ordered from the end, so inserting one
+		// does not move the position of the next.
+		result = result[:i] + string(s.separator) + result[i:]
+	}
+
+	return result, true
+}
+
+// hasLiteralHyphen checks if a regular expression matches a hyphen,
+// ignoring hyphens that are used for ranges in character classes.
+func hasLiteralHyphen(regex string) bool {
+	inClass := false
+
+	for i := 0; i < len(regex); i++ {
+		switch regex[i] {
+		case '\\':
+			if i+1 < len(regex) && regex[i+1] == '-' {
+				return true
+			}
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '-':
+			if !inClass {
+				return true
+			}
+		}
+	}
+
+	return false
+}
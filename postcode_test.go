@@ -0,0 +1,239 @@
+package libaddress
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPostCodePrefix(t *testing.T) {
+
+	tests := []struct {
+		Country  string
+		PostCode string
+		Valid    bool
+	}{
+		{Country: "PR", PostCode: "00901", Valid: true},
+		{Country: "PR", PostCode: "PR 00901", Valid: true},
+		{Country: "PR", PostCode: "pr-00901", Valid: true},
+		{Country: "FI", PostCode: "FI-00100", Valid: true},
+		{Country: "FI", PostCode: "00100", Valid: true},
+		{Country: "AX", PostCode: "AX-22100", Valid: true},
+		{Country: "AD", PostCode: "AD500", Valid: true},
+		{Country: "AU", PostCode: "3000", Valid: true},
+		{Country: "AU", PostCode: "AU 3000", Valid: false},
+	}
+
+	for i, testCase := range tests {
+		data := generated.getCountry(testCase.Country)
+		address := New(
+			WithCountry(testCase.Country),
+			WithAdministrativeArea("VIC"),
+			WithPostCode(testCase.PostCode),
+		)
+
+		for _, strict := range []bool{false, true} {
+			err := checkPostCodeWithPrefix(address, data, strict)
+
+			if testCase.Valid && err != nil {
+				t.Errorf("Expected post code %s in test case %d to be valid, got %s", testCase.PostCode, i, err)
+			}
+
+			if !testCase.Valid && err == nil {
+				t.Errorf("Expected post code %s in test case %d to be invalid", testCase.PostCode, i)
+			}
+		}
+	}
+
+	data := generated.getCountry("FI")
+	allocs := testing.AllocsPerRun(100, func() {
+		postCodePrefixes(data)
+	})
+
+	if allocs > 0 {
+		t.Errorf("Expected the post code prefixes to be cached, got %v allocations", allocs)
+	}
+}
+
+func TestStrictPostCodes(t *testing.T) {
+
+	var countries []string
+	for cc := range generated {
+		countries = append(countries, cc)
+	}
+
+	sort.Strings(countries)
+
+	strict := NewValidator()
+	lenient := NewValidator(LenientPostCodes())
+
+	for _, cc := range countries {
+		if generated.getCountry(cc).PostCodeRegex.regex == "" {
+			continue
+		}
+
+		examples := ExamplePostCodes(cc)
+		if len(examples) == 0 {
+			t.Errorf("Expected example post codes for %s", cc)
+			continue
+		}
+
+		for _, example := range examples {
+			if err := strict.ValidateField(cc, PostCode, example, Address{}); err != nil {
+				t.Errorf("Expected example post code %s to be valid for %s, got %s", example, cc, err)
+			}
+
+			if err := strict.ValidateField(cc, PostCode, " "+example+" ", Address{}); err != nil {
+				t.Errorf("Expected example post code %s with surrounding spaces to be valid for %s, got %s", example, cc, err)
+			}
+
+			junk := "X" + example + "X"
+
+			if err := strict.ValidateField(cc, PostCode, junk, Address{}); err == nil {
+				t.Errorf("Expected post code %s to be invalid for %s in strict mode", junk, cc)
+			}
+
+			if err := lenient.ValidateField(cc, PostCode, junk, Address{}); err != nil {
+				t.Errorf("Expected post code %s to be valid for %s in lenient mode, got %s", junk, cc, err)
+			}
+		}
+	}
+
+	if err := strict.ValidateField("AU", PostCode, "123456", Address{}); err == nil {
+		t.Errorf("Expected post code 123456 to be invalid for AU in strict mode")
+	}
+}
+
+func TestNormalizePostCode(t *testing.T) {
+
+	tests := []struct {
+		Country  string
+		PostCode string
+		Expected string
+	}{
+		{Country: "GB", PostCode: "sw1a2aa", Expected: "SW1A 2AA"},
+		{Country: "GB", PostCode: "SW1A-1AA", Expected: "SW1A 1AA"},
+		{Country: "GB", PostCode: " m2  5bq ", Expected: "M2 5BQ"},
+		{Country: "GB", PostCode: "bfpo61", Expected: "BFPO 61"},
+		{Country: "NL", PostCode: "1234AB", Expected: "1234 AB"},
+		{Country: "JP", PostCode: "1000001", Expected: "100-0001"},
+		{Country: "JP", PostCode: "100-0001", Expected: "100-0001"},
+		{Country: "JP", PostCode: "100 0001", Expected: "100-0001"},
+		{Country: "CA", PostCode: "k1a0b1", Expected: "K1A 0B1"},
+		{Country: "CA", PostCode: "H3Z2Y7", Expected: "H3Z 2Y7"},
+		{Country: "CZ", PostCode: "10000", Expected: "100 00"},
+		{Country: "US", PostCode: "95014", Expected: "95014"},
+		{Country: "US", PostCode: "221621010", Expected: "22162-1010"},
+		{Country: "US", PostCode: "22162 1010", Expected: "22162-1010"},
+		{Country: "FR", PostCode: "75 001", Expected: "75001"},
+		{Country: "BN", PostCode: "bt 2328", Expected: "BT2328"},
+		{Country: "PR", PostCode: "pr 00901", Expected: "00901"},
+		{Country: "FI", PostCode: "fi-00100", Expected: "00100"},
+		{Country: "AD", PostCode: "ad700", Expected: "AD700"},
+		{Country: "AU", PostCode: "3000", Expected: "3000"},
+	}
+
+	for i, testCase := range tests {
+		postCode, err := NormalizePostCode(testCase.Country, testCase.PostCode)
+
+		if err != nil {
+			t.Errorf("Unexpected error normalizing post code in test case %d: %s", i, err)
+			continue
+		}
+
+		if postCode != testCase.Expected {
+			t.Errorf("Expected post code in test case %d to be normalized to %s, got %s", i, testCase.Expected, postCode)
+		}
+	}
+
+	// Without examples, separators are only
+	// inserted where the regex requires them.
+	noExamples := generated.getCountry("US")
+	noExamples.PostCodeRegex.examples = nil
+
+	for postCode, expected := range map[string]string{"95014": "95014", "221621010": "22162-1010"} {
+		if normalized, err := normalizePostCode(noExamples, postCode); err != nil || normalized != expected {
+			t.Errorf("Expected post code %s without examples to be normalized to %s, got %s and %v", postCode, expected, normalized, err)
+		}
+	}
+
+	// Example post codes are already normalized.
+	checked := 0
+	for cc := range generated {
+		for _, example := range ExamplePostCodes(cc) {
+			checked++
+
+			if postCode, err := NormalizePostCode(cc, example); err != nil || postCode != example {
+				t.Errorf("Expected example post code %s for %s to be unchanged when normalized, got %s and %v", example, cc, postCode, err)
+			}
+		}
+	}
+
+	if checked == 0 {
+		t.Errorf("Expected the data to contain example post codes")
+	}
+
+	if _, err := NormalizePostCode("AU", "123456"); err != ErrInvalidPostCode {
+		t.Errorf("Expected ErrInvalidPostCode when normalizing an invalid post code, got %v", err)
+	}
+
+	if _, err := NormalizePostCode("XX", "3000"); err != ErrInvalidCountryCode {
+		t.Errorf("Expected ErrInvalidCountryCode when normalizing a post code in an invalid country, got %v", err)
+	}
+
+	validator := NewValidator(NormalizePostCodes())
+
+	if err := validator.ValidateField("GB", PostCode, "sw1a2aa", Address{}); err != nil {
+		t.Errorf("Expected post code to be valid after it is normalized, got %s", err)
+	}
+}
+
+func TestExamplePostCodes(t *testing.T) {
+
+	regex := postCodeRegex{
+		regex:    `\d{4}`,
+		examples: []string{"2060", "3171"},
+		subdivisionRegex: map[string]postCodeRegex{
+			"NSW": {regex: `^2`},
+			"VIC": {
+				regex:    `^3`,
+				examples: []string{"3000"},
+				subdivisionRegex: map[string]postCodeRegex{
+					"Melbourne": {regex: `^300`},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		SubdivisionIDs []string
+		Expected       []string
+	}{
+		{SubdivisionIDs: nil, Expected: []string{"2060", "3171"}},
+		{SubdivisionIDs: []string{"VIC"}, Expected: []string{"3000"}},
+		{SubdivisionIDs: []string{"VIC", "Melbourne"}, Expected: []string{"3000"}},
+		{SubdivisionIDs: []string{"NSW"}, Expected: []string{"2060", "3171"}},
+		{SubdivisionIDs: []string{"ASDF", "Melbourne"}, Expected: []string{"2060", "3171"}},
+	}
+
+	for i, testCase := range tests {
+		examples := subdivisionExamples(regex, testCase.SubdivisionIDs...)
+
+		if !reflect.DeepEqual(examples, testCase.Expected) {
+			t.Errorf("Example post codes in test case %d do not match expected post codes, got %v", i, examples)
+		}
+	}
+
+	expected := []string{"2060", "3171", "6430", "4000", "4006", "3001"}
+	if examples := ExamplePostCodes("au", "VIC"); !reflect.DeepEqual(examples, expected) {
+		t.Errorf("Expected the example post codes of AU to be used for VIC, got %v", examples)
+	}
+
+	if examples := ExamplePostCodes("AO"); examples != nil {
+		t.Errorf("Expected no example post codes for a country without post codes, got %v", examples)
+	}
+
+	if examples := ExamplePostCodes("XX"); examples != nil {
+		t.Errorf("Expected no example post codes for an invalid country, got %v", examples)
+	}
+}
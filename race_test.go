@@ -0,0 +1,8 @@
+//go:build race
+// +build race
+
+package libaddress
+
+// raceEnabled reports if the tests are run with the race detector,
+// which allocates when instrumenting memory accesses.
+const raceEnabled = true
@@ -0,0 +1,246 @@
+package libaddress
+
+import (
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SubdivisionSearchResult is a subdivision found by SearchSubdivisions.
+// Field is the level of the subdivision and Path contains the
+// subdivision and its parents, starting with the administrative area,
+// so that selecting a locality also selects its administrative area.
+type SubdivisionSearchResult struct {
+	Field Field
+	Path  []Subdivision
+}
+
+// Ranks of search matches, from best to worst.
+const (
+	matchExact = iota
+	matchPrefix
+	matchWordPrefix
+	matchInfix
+)
+
+// searchEntry is a subdivision in a search index, with the
+// loose keys of its name, postal key and latinized name.
+type searchEntry struct {
+	field Field
+	path  []Subdivision
+	keys  []string
+}
+
+// searchIndexes caches the search index of each country and
+// language. An index is built the first time it is searched.
+var searchIndexes sync.Map
+
+// SearchSubdivisions returns the administrative areas, localities and
+// dependent localities of a country that match a query, for example to
+// autocomplete subdivisions as they are typed. The query is matched
+// against the start and the middle of the names, postal keys and
+// latinized names of the subdivisions, ignoring case, diacritics and
+// width, so that "sao" matches São Paulo and "ｔｏｋｙｏ" matches Tokyo.
+//
+// Exact matches are returned first, followed by names starting with
+// the query, names with a word starting with the query and names
+// containing the query. Within each rank, administrative areas are
+// returned before localities and dependent localities, sorted according
+// to the language. The language falls back to the default language of
+// the country in the same way as AdministrativeAreaName. If limit is
+// greater than 0, at most limit results are returned.
+func SearchSubdivisions(cc, query, language string, limit int) []SubdivisionSearchResult {
+	cc = countryCode(cc)
+	key := looseKey(strings.TrimSpace(query))
+
+	if key == "" || !generated.hasCountry(cc) {
+		return nil
+	}
+
+	lang := generated.normalizeLanguage(cc, language)
+
+	type match struct {
+		entry *searchEntry
+		rank  int
+	}
+
+	var matches []match
+	entries := generated.searchIndex(cc, lang)
+
+	for i := range entries {
+		if rank, ok := searchRank(entries[i].keys, key); ok {
+			matches = append(matches, match{entry: &entries[i], rank: rank})
+		}
+	}
+
+	collator := searchCollator(lang)
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if len(a.entry.path) != len(b.entry.path) {
+			return len(a.entry.path) < len(b.entry.path)
+		}
+		return collator.CompareString(a.entry.name(), b.entry.name()) < 0
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	results := make([]SubdivisionSearchResult, len(matches))
+	for i, m := range matches {
+		results[i] = SubdivisionSearchResult{
+			Field: m.entry.field,
+			Path:  append([]Subdivision(nil), m.entry.path...),
+		}
+	}
+
+	return results
+}
+
+// searchRank returns the best rank of the keys that match the query.
+func searchRank(keys []string, query string) (int, bool) {
+	best, found := matchInfix, false
+
+	for _, key := range keys {
+		index := strings.Index(key, query)
+		if index < 0 {
+			continue
+		}
+
+		rank := matchInfix
+		switch {
+		case key == query:
+			rank = matchExact
+		case index == 0:
+			rank = matchPrefix
+		case isWordStart(key, query):
+			rank = matchWordPrefix
+		}
+
+		if !found || rank < best {
+			best, found = rank, true
+		}
+	}
+
+	return best, found
+}
+
+// isWordStart reports whether a word in the key starts with the query.
+func isWordStart(key, query string) bool {
+	for i := 1; i < len(key); i++ {
+		if strings.IndexByte(" -'", key[i-1]) >= 0 && strings.HasPrefix(key[i:], query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func searchCollator(lang string) *collate.Collator {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		tag = language.Und
+	}
+
+	return collate.New(tag)
+}
+
+func (e *searchEntry) name() string {
+	return e.path[len(e.path)-1].Name
+}
+
+func (d data) searchIndex(cc, lang string) []searchEntry {
+	cacheKey := cc + "/" + lang
+
+	if entries, ok := searchIndexes.Load(cacheKey); ok {
+		return entries.([]searchEntry)
+	}
+
+	entries, _ := searchIndexes.LoadOrStore(cacheKey, newSearchIndex(d.getCountry(cc), lang, d.subdivisionIndex(cc)))
+
+	return entries.([]searchEntry)
+}
+
+func newSearchIndex(c country, lang string, index subdivisionIndex) []searchEntry {
+	var entries []searchEntry
+
+	// Latinized names are stored under the latinized language, so
+	// there are no other names to add when searching in it.
+	latinized := func(path SubdivisionPath) string {
+		if lang == latinizedLanguage {
+			return ""
+		}
+
+		switch {
+		case path.DependentLocality != "":
+			if dl, ok := index.dependentLocality(latinizedLanguage, path.AdministrativeArea, path.Locality, path.DependentLocality); ok {
+				return dl.Name
+			}
+		case path.Locality != "":
+			if l, ok := index.locality(latinizedLanguage, path.AdministrativeArea, path.Locality); ok {
+				return l.locality.Name
+			}
+		default:
+			if area, ok := index.administrativeArea(latinizedLanguage, path.AdministrativeArea); ok {
+				return area.area.Name
+			}
+		}
+
+		return ""
+	}
+
+	keys := func(names ...string) []string {
+		var result []string
+		for _, name := range names {
+			if key := looseKey(name); key != "" {
+				result = append(result, key)
+			}
+		}
+		return result
+	}
+
+	for _, area := range c.AdministrativeAreas[lang] {
+		areaPath := SubdivisionPath{AdministrativeArea: area.ID}
+		areaSubdivision := Subdivision{
+			ID:        area.ID,
+			Name:      area.Name,
+			PostalKey: generated.getAdministrativeAreaPostalKey(c.ID, area.ID),
+			ISOCode:   isoSubdivisionCode(c, area.ID),
+		}
+
+		entries = append(entries, searchEntry{
+			field: AdministrativeArea,
+			path:  []Subdivision{areaSubdivision},
+			keys:  keys(area.Name, areaSubdivision.PostalKey, latinized(areaPath)),
+		})
+
+		for _, l := range area.Localities {
+			localityPath := SubdivisionPath{AdministrativeArea: area.ID, Locality: l.ID}
+			localitySubdivision := Subdivision{ID: l.ID, Name: l.Name}
+
+			entries = append(entries, searchEntry{
+				field: Locality,
+				path:  []Subdivision{areaSubdivision, localitySubdivision},
+				keys:  keys(l.Name, latinized(localityPath)),
+			})
+
+			for _, dl := range l.DependentLocalities {
+				dependentLocalityPath := SubdivisionPath{AdministrativeArea: area.ID, Locality: l.ID, DependentLocality: dl.ID}
+
+				entries = append(entries, searchEntry{
+					field: DependentLocality,
+					path:  []Subdivision{areaSubdivision, localitySubdivision, {ID: dl.ID, Name: dl.Name}},
+					keys:  keys(dl.Name, latinized(dependentLocalityPath)),
+				})
+			}
+		}
+	}
+
+	return entries
+}
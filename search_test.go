@@ -0,0 +1,110 @@
+package libaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchSubdivisions(t *testing.T) {
+
+	tests := []struct {
+		Country  string
+		Query    string
+		Language string
+		Limit    int
+		Expected [][]string // The IDs in the path of each result
+	}{
+		{
+			Country:  "BR",
+			Query:    "sao",
+			Limit:    1,
+			Expected: [][]string{{"SP"}},
+		},
+		{
+			Country:  "JP",
+			Query:    "ｔｏｋｙｏ", // Latinized name in full width
+			Language: "ja",
+			Expected: [][]string{{"13"}},
+		},
+		{
+			Country:  "jp",
+			Query:    "東京",
+			Expected: [][]string{{"13"}},
+		},
+		{
+			Country:  "KR",
+			Query:    "포항",
+			Language: "ko",
+			Expected: [][]string{{"47", "포항시"}},
+		},
+		{
+			Country:  "KR",
+			Query:    "pohang",
+			Language: "ko",
+			Expected: [][]string{{"47", "포항시"}},
+		},
+		{
+			Country:  "CN",
+			Query:    "临翔",
+			Language: "zh",
+			Limit:    1,
+			Expected: [][]string{{"53", "临沧市", "临翔区"}},
+		},
+		{
+			Country:  "AU",
+			Query:    "asdf",
+			Expected: nil,
+		},
+		{
+			Country:  "XX",
+			Query:    "sao",
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range tests {
+		results := SearchSubdivisions(testCase.Country, testCase.Query, testCase.Language, testCase.Limit)
+
+		var paths [][]string
+		for _, result := range results {
+			var ids []string
+			for _, subdivision := range result.Path {
+				ids = append(ids, subdivision.ID)
+			}
+			paths = append(paths, ids)
+		}
+
+		if !reflect.DeepEqual(paths, testCase.Expected) {
+			t.Errorf("Search results in test case %d do not match expected results, got %v", i, paths)
+		}
+	}
+}
+
+func TestSearchSubdivisionsRanking(t *testing.T) {
+
+	results := SearchSubdivisions("AU", "new", "en", 0)
+	if len(results) == 0 || results[0].Path[0].ID != "NSW" {
+		t.Fatalf("Expected New South Wales to be the first result, got %v", results)
+	}
+
+	// Queries matching the start of a name are ranked above queries
+	// matching the start of a word, which are ranked above infixes
+	// sorted by name.
+	results = SearchSubdivisions("AU", "s", "en", 0)
+
+	var ids []string
+	for _, result := range results {
+		ids = append(ids, result.Path[0].ID)
+	}
+
+	expected := []string{"SA", "NSW", "ACT", "QLD", "TAS", "WA"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected search results %v, got %v", expected, ids)
+	}
+
+	for _, result := range SearchSubdivisions("KR", "구", "ko", 0) {
+		if result.Field == DependentLocality && len(result.Path) != 3 {
+			t.Errorf("Expected dependent locality to include its parents, got %v", result.Path)
+		}
+	}
+}
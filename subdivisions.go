@@ -0,0 +1,209 @@
+package libaddress
+
+import (
+	"strings"
+)
+
+// Subdivision contains the ID and name of an administrative area,
+// locality or dependent locality. The ID must be passed to
+// WithAdministrativeArea(), WithLocality() or WithDependentLocality()
+// when creating an address. The name is useful for displaying to the
+// end user. PostalKey and ISOCode are only set for administrative
+// areas. PostalKey is the value expected by the postal operator, such
+// as VIC in Australia, and ISOCode is the ISO 3166-2 code, such as
+// AU-VIC. ISOCode is empty if the administrative area does not have
+// an ISO code (see SubdivisionISOCode).
+type Subdivision struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	PostalKey string `json:"postal_key,omitempty"`
+	ISOCode   string `json:"iso_code,omitempty"`
+}
+
+// AdministrativeAreaName returns the name of an administrative area,
+// such as Victoria for VIC in AU. Language must be an ISO 639-1
+// language code that the country has translations for, such as en
+// in CA or zh-Hant in TW. If the country does not have translations
+// for the language, the default language of the country is used.
+// An empty string is returned if the administrative area does not
+// exist.
+func AdministrativeAreaName(cc, areaID, language string) string {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return ""
+	}
+
+	return generated.getAdministrativeAreaName(cc, areaID, language)
+}
+
+// AdministrativeAreaPostalKey returns the postal key of an
+// administrative area, which is the value expected by the postal
+// operator of the country. An empty string is returned if the
+// administrative area does not exist.
+func AdministrativeAreaPostalKey(cc, areaID string) string {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return ""
+	}
+
+	return generated.getAdministrativeAreaPostalKey(cc, areaID)
+}
+
+// LocalityName returns the name of a locality in an administrative
+// area. The language falls back to the default language of the
+// country in the same way as AdministrativeAreaName. An empty string
+// is returned if the locality does not exist.
+func LocalityName(cc, areaID, localityID, language string) string {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return ""
+	}
+
+	return generated.getLocalityName(cc, areaID, localityID, language)
+}
+
+// DependentLocalityName returns the name of a dependent locality in
+// a locality. The language falls back to the default language of the
+// country in the same way as AdministrativeAreaName. An empty string
+// is returned if the dependent locality does not exist.
+func DependentLocalityName(cc, areaID, localityID, dependentLocalityID, language string) string {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return ""
+	}
+
+	return generated.getDependentLocalityName(cc, areaID, localityID, dependentLocalityID, language)
+}
+
+// LookupSubdivisions returns the administrative area, locality and
+// dependent locality of an address, in that order, with their names
+// in the language. The lookup stops at the first subdivision that is
+// empty or does not exist, so an address in Melbourne, VIC only returns
+// Victoria, as Australia does not have localities in the data. The
+// language falls back to the default language of the country in the
+// same way as AdministrativeAreaName.
+func LookupSubdivisions(address Address, language string) []Subdivision {
+	cc := countryCode(address.Country)
+	if !generated.hasCountry(cc) {
+		return nil
+	}
+
+	lang := generated.normalizeLanguage(cc, language)
+	index := generated.subdivisionIndex(cc)
+
+	areaID := strings.TrimSpace(address.AdministrativeArea)
+	localityID := strings.TrimSpace(address.Locality)
+	dependentLocalityID := strings.TrimSpace(address.DependentLocality)
+
+	area, ok := index.administrativeArea(lang, areaID)
+	if areaID == "" || !ok {
+		return nil
+	}
+
+	path := []Subdivision{{
+		ID:        area.area.ID,
+		Name:      area.area.Name,
+		PostalKey: generated.getAdministrativeAreaPostalKey(cc, areaID),
+		ISOCode:   isoSubdivisionCode(generated.getCountry(cc), areaID),
+	}}
+
+	l, ok := index.locality(lang, areaID, localityID)
+	if localityID == "" || !ok {
+		return path
+	}
+
+	path = append(path, Subdivision{ID: l.locality.ID, Name: l.locality.Name})
+
+	dl, ok := index.dependentLocality(lang, areaID, localityID, dependentLocalityID)
+	if dependentLocalityID == "" || !ok {
+		return path
+	}
+
+	return append(path, Subdivision{ID: dl.ID, Name: dl.Name})
+}
+
+// AdministrativeAreas returns the administrative areas of a country,
+// without their localities, so that they can be displayed one level at
+// a time, for example in cascading drop downs. The language falls back
+// to the default language of the country in the same way as
+// AdministrativeAreaName. The administrative areas are sorted according
+// to the sort order of the language they are in.
+func AdministrativeAreas(cc, language string) []Subdivision {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return nil
+	}
+
+	data := generated.getCountry(cc)
+	areas := data.AdministrativeAreas[generated.normalizeLanguage(cc, language)]
+
+	if len(areas) == 0 {
+		return nil
+	}
+
+	result := make([]Subdivision, len(areas))
+	for i, area := range areas {
+		result[i] = Subdivision{
+			ID:        area.ID,
+			Name:      area.Name,
+			PostalKey: generated.getAdministrativeAreaPostalKey(cc, area.ID),
+			ISOCode:   isoSubdivisionCode(data, area.ID),
+		}
+	}
+
+	return result
+}
+
+// Localities returns the localities of an administrative area, without
+// their dependent localities. The language falls back to the default
+// language of the country in the same way as AdministrativeAreaName.
+// The localities are sorted according to the sort order of the language
+// they are in. If the administrative area does not exist or does not
+// have localities, nil is returned.
+func Localities(cc, areaID, language string) []Subdivision {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return nil
+	}
+
+	lang := generated.normalizeLanguage(cc, language)
+
+	area, ok := generated.subdivisionIndex(cc).administrativeArea(lang, areaID)
+	if !ok || len(area.area.Localities) == 0 {
+		return nil
+	}
+
+	result := make([]Subdivision, len(area.area.Localities))
+	for i, l := range area.area.Localities {
+		result[i] = Subdivision{ID: l.ID, Name: l.Name}
+	}
+
+	return result
+}
+
+// DependentLocalities returns the dependent localities of a locality.
+// The language falls back to the default language of the country in the
+// same way as AdministrativeAreaName. The dependent localities are
+// sorted according to the sort order of the language they are in. If
+// the locality does not exist or does not have dependent localities,
+// nil is returned.
+func DependentLocalities(cc, areaID, localityID, language string) []Subdivision {
+	cc = countryCode(cc)
+	if !generated.hasCountry(cc) {
+		return nil
+	}
+
+	lang := generated.normalizeLanguage(cc, language)
+
+	l, ok := generated.subdivisionIndex(cc).locality(lang, areaID, localityID)
+	if !ok || len(l.locality.DependentLocalities) == 0 {
+		return nil
+	}
+
+	result := make([]Subdivision, len(l.locality.DependentLocalities))
+	for i, dl := range l.locality.DependentLocalities {
+		result[i] = Subdivision{ID: dl.ID, Name: dl.Name}
+	}
+
+	return result
+}
@@ -0,0 +1,152 @@
+package libaddress
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubdivisionNames(t *testing.T) {
+
+	tests := []struct {
+		Name     string
+		Expected string
+	}{
+		{AdministrativeAreaName("AU", "VIC", "en"), "Victoria"},
+		{AdministrativeAreaName("ca", "QC", "fr"), "Québec"},
+		{AdministrativeAreaName("CA", "QC", "de"), "Quebec"}, // Falls back to the default language
+		{AdministrativeAreaName("AU", "ASDF", "en"), ""},
+		{AdministrativeAreaPostalKey("KR", "47"), "경상북도"},
+		{LocalityName("KR", "47", "포항시", "en"), "Pohang-si"},
+		{LocalityName("KR", "47", "포항시", ""), "포항시"},
+		{DependentLocalityName("KR", "47", "포항시", "북구", "en"), "Buk-gu"},
+		{DependentLocalityName("KR", "47", "포항시", "ASDF", "en"), ""},
+	}
+
+	for i, testCase := range tests {
+		if testCase.Name != testCase.Expected {
+			t.Errorf("Subdivision name in test case %d does not match expected name %s, got %s", i, testCase.Expected, testCase.Name)
+		}
+	}
+}
+
+func TestLookupSubdivisions(t *testing.T) {
+
+	tests := []struct {
+		Address  Address
+		Language string
+		Expected []Subdivision
+	}{
+		{
+			Address: New(
+				WithCountry("KR"),
+				WithAdministrativeArea("47"),
+				WithLocality("포항시"),
+				WithDependentLocality("북구"),
+			),
+			Language: "en",
+			Expected: []Subdivision{
+				{ID: "47", Name: "Gyeongsangbuk-do", PostalKey: "경상북도", ISOCode: "KR-47"},
+				{ID: "포항시", Name: "Pohang-si"},
+				{ID: "북구", Name: "Buk-gu"},
+			},
+		},
+		{
+			Address: New(
+				WithCountry("KR"),
+				WithAdministrativeArea("47"),
+				WithLocality("ASDF"),
+				WithDependentLocality("북구"),
+			),
+			Language: "ko",
+			Expected: []Subdivision{
+				{ID: "47", Name: "경북", PostalKey: "경상북도", ISOCode: "KR-47"},
+			},
+		},
+		{
+			Address: New(
+				WithCountry("AU"),
+				WithAdministrativeArea("VIC"),
+				WithLocality("Melbourne"),
+			),
+			Language: "",
+			Expected: []Subdivision{
+				{ID: "VIC", Name: "Victoria", PostalKey: "VIC", ISOCode: "AU-VIC"},
+			},
+		},
+		{
+			Address: New(
+				WithCountry("AU"),
+				WithAdministrativeArea("ASDF"),
+			),
+			Language: "en",
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range tests {
+		path := LookupSubdivisions(testCase.Address, testCase.Language)
+
+		if !reflect.DeepEqual(path, testCase.Expected) {
+			t.Errorf("Subdivisions in test case %d do not match expected subdivisions, got %v", i, path)
+		}
+	}
+}
+
+func TestSubdivisionNavigation(t *testing.T) {
+
+	tests := []struct {
+		Subdivisions []Subdivision
+		Expected     []string
+	}{
+		{Localities("AU", "VIC", "en"), nil},
+		{Localities("KR", "ASDF", "ko"), nil},
+		{DependentLocalities("KR", "47", "포항시", "ko"), []string{"남구", "북구"}},
+		{DependentLocalities("kr", "47", "포항시", "en"), []string{"북구", "남구"}}, // Buk-gu is sorted before Nam-gu
+		{DependentLocalities("KR", "47", "경주시", "ko"), nil},
+	}
+
+	for i, testCase := range tests {
+		var ids []string
+		for _, subdivision := range testCase.Subdivisions {
+			ids = append(ids, subdivision.ID)
+		}
+
+		if !reflect.DeepEqual(ids, testCase.Expected) {
+			t.Errorf("Subdivisions in test case %d do not match expected subdivisions, got %v", i, ids)
+		}
+	}
+
+	// The subdivisions must be in the order they were generated in,
+	// which is the sort order of their language.
+	for _, lang := range []string{"ko", "en", "de"} {
+		c := generated["KR"]
+		areas := c.AdministrativeAreas[generated.normalizeLanguage("KR", lang)]
+		result := AdministrativeAreas("KR", lang)
+
+		if len(result) != len(areas) {
+			t.Fatalf("Expected %d administrative areas in KR (%s), got %d", len(areas), lang, len(result))
+		}
+
+		for i, area := range areas {
+			if result[i].ID != area.ID || result[i].Name != area.Name || result[i].PostalKey == "" {
+				t.Errorf("Administrative area %d in KR (%s) does not match generated data, got %v", i, lang, result[i])
+			}
+
+			localities := Localities("KR", area.ID, lang)
+			if len(localities) != len(area.Localities) {
+				t.Errorf("Expected %d localities in KR/%s (%s), got %d", len(area.Localities), area.ID, lang, len(localities))
+				continue
+			}
+
+			for j, l := range area.Localities {
+				if localities[j].ID != l.ID || localities[j].Name != l.Name {
+					t.Errorf("Locality %d in KR/%s (%s) does not match generated data, got %v", j, area.ID, lang, localities[j])
+				}
+			}
+		}
+	}
+
+	if areas := AdministrativeAreas("XX", "en"); areas != nil {
+		t.Errorf("Expected no administrative areas in an invalid country, got %v", areas)
+	}
+}
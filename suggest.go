@@ -0,0 +1,209 @@
+package libaddress
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxSuggestions is the maximum number of
+// suggestions attached to a validation error.
+const maxSuggestions = 5
+
+// suggestion is a candidate correction for an invalid value.
+type suggestion struct {
+	id       string
+	distance int
+	order    int
+}
+
+// suggestSubdivisions returns the IDs of the subdivisions at the level
+// of the field that are closest to the value of the field, such as SP
+// for Sao Paolo. The IDs, names and postal keys of the subdivisions in
+// every language of the country are compared to the value, ignoring
+// case, diacritics and width. Localities and dependent localities are
+// only suggested within the administrative area and locality of the
+// address.
+func suggestSubdivisions(data country, address Address, field Field) []string {
+	value := getField(address, field)
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	key := looseKey(value)
+	maxDistance := utf8.RuneCountInString(key) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	collator := collate.New(language.Und, collate.IgnoreCase, collate.IgnoreDiacritics, collate.IgnoreWidth)
+	best := map[string]*suggestion{}
+
+	add := func(id string, names ...string) {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			distance := levenshtein(key, looseKey(name))
+			if collator.CompareString(value, name) == 0 {
+				distance = 0
+			}
+
+			if distance > maxDistance {
+				continue
+			}
+
+			if s, ok := best[id]; !ok {
+				best[id] = &suggestion{id: id, distance: distance, order: len(best)}
+			} else if distance < s.distance {
+				s.distance = distance
+			}
+		}
+	}
+
+	index := generated.subdivisionIndex(data.ID)
+
+	for _, lang := range subdivisionLanguages(data, "") {
+		switch field {
+		case AdministrativeArea:
+			for _, area := range data.AdministrativeAreas[lang] {
+				add(area.ID, area.ID, area.Name, area.PostalKey)
+			}
+
+		case Locality:
+			if area, ok := index.administrativeArea(lang, address.AdministrativeArea); ok {
+				for _, l := range area.area.Localities {
+					add(l.ID, l.ID, l.Name)
+				}
+			}
+
+		case DependentLocality:
+			if l, ok := index.locality(lang, address.AdministrativeArea, address.Locality); ok {
+				for _, dl := range l.locality.DependentLocalities {
+					add(dl.ID, dl.ID, dl.Name)
+				}
+			}
+		}
+	}
+
+	suggestions := make([]*suggestion, 0, len(best))
+	for _, s := range best {
+		suggestions = append(suggestions, s)
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].order < suggestions[j].order
+	})
+
+	var ids []string
+	for i := 0; i < len(suggestions) && i < maxSuggestions; i++ {
+		ids = append(ids, suggestions[i].id)
+	}
+
+	return ids
+}
+
+// suggestPostCodes returns post codes that are valid for the
+// country and only differ from the post code by case, spacing,
+// punctuation or a prefix, such as SW1A 2AA for sw1a2aa.
+func suggestPostCodes(data country, postCode string) []string {
+	if data.PostCodeRegex.regex == "" {
+		return nil
+	}
+
+	compact := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(stripPostCodePrefix(data, strings.TrimSpace(postCode))))
+
+	if compact == "" {
+		return nil
+	}
+
+	// Post codes with separators are suggested first, as
+	// the separator is optional in some countries.
+	var candidates []string
+	for i := 1; i < len(compact); i++ {
+		candidates = append(candidates, compact[:i]+" "+compact[i:], compact[:i]+"-"+compact[i:])
+	}
+
+	candidates = append(candidates, compact)
+
+	var suggestions []string
+	for _, candidate := range candidates {
+		if candidate != postCode && matchesPostCode(data, candidate) {
+			suggestions = append(suggestions, candidate)
+		}
+
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+	}
+
+	return suggestions
+}
+
+// looseKey folds a string so that strings that only differ
+// by case, diacritics or width have the same key.
+func looseKey(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFKC)
+
+	result, _, err := transform.String(t, s)
+	if err != nil {
+		result = s
+	}
+
+	return cases.Fold().String(normalizeSpace(result))
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+
+	previous := make([]int, len(t)+1)
+	current := make([]int, len(t)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(t)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,100 @@
+package libaddress
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSuggestions(t *testing.T) {
+
+	tests := []struct {
+		Address  Address
+		Field    Field
+		Expected string
+	}{
+		{
+			Address: New(
+				WithStreetAddress([]string{"Avenida Paulista 1578"}),
+				WithLocality("São Paulo"),
+				WithAdministrativeArea("Sao Paolo"),
+				WithPostCode("01310-200"),
+				WithCountry("BR"),
+			),
+			Field:    AdministrativeArea,
+			Expected: "SP",
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("Victria"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Field:    AdministrativeArea,
+			Expected: "VIC",
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{"No.1 Jianguomenwai Avenue"}),
+				WithDependentLocality("临翔区"),
+				WithLocality("Lincan Shi"),
+				WithAdministrativeArea("53"),
+				WithPostCode("677000"),
+				WithCountry("CN"),
+			),
+			Field:    Locality,
+			Expected: "临沧市",
+		},
+		{
+			Address: New(
+				WithStreetAddress([]string{"10 Downing Street"}),
+				WithLocality("London"),
+				WithPostCode("sw1a2aa"),
+				WithCountry("GB"),
+			),
+			Field:    PostCode,
+			Expected: "SW1A 2AA",
+		},
+	}
+
+	for i, testCase := range tests {
+		var validationErrors ValidationErrors
+		if err := Validate(testCase.Address); !errors.As(err, &validationErrors) {
+			t.Errorf("Expected validation errors in test case %d, got %v", i, err)
+			continue
+		}
+
+		var suggestions []string
+		for _, err := range validationErrors {
+			if err.Field == testCase.Field {
+				suggestions = err.Suggestions
+			}
+		}
+
+		if len(suggestions) == 0 || suggestions[0] != testCase.Expected {
+			t.Errorf("Expected %s to be suggested in test case %d, got %v", testCase.Expected, i, suggestions)
+		}
+	}
+}
+
+func TestSuggestionsNoMatch(t *testing.T) {
+
+	err := Validate(New(
+		WithStreetAddress([]string{"525 Collins Street"}),
+		WithLocality("Melbourne"),
+		WithAdministrativeArea("ASDF"),
+		WithPostCode("3000"),
+		WithCountry("AU"),
+	))
+
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("Expected validation errors, got %v", err)
+	}
+
+	if !reflect.DeepEqual(validationErrors[0].Suggestions, []string(nil)) {
+		t.Errorf("Expected no suggestions for an administrative area that does not match, got %v", validationErrors[0].Suggestions)
+	}
+}
@@ -1,10 +1,8 @@
 package libaddress
 
 import (
-	"github.com/hashicorp/go-multierror"
-	"regexp"
-	"strings"
 	"fmt"
+	"strings"
 )
 
 func ListFormatFunc(es []error) string {
@@ -19,246 +17,180 @@ func ListFormatFunc(es []error) string {
 // Validate checks an address to determine if it
 // is valid. To create a valid address, the
 // `address.NewValid()` function can do it
-// in one call.
+// in one call. If the address is invalid, the
+// error is a ValidationErrors listing the
+// problems with each field. The country can
+// be an ISO 3166-1 alpha-2, alpha-3 or
+// numeric code.
 func Validate(address Address) error {
-	result := new(multierror.Error)
-	result.ErrorFormat = ListFormatFunc
-
-	if !generated.hasCountry(address.Country) {
-		result = multierror.Append(result, ErrInvalidCountryCode)
-		return result
-	}
-
-	var data country = generated.getCountry(address.Country)
-
-	if err := checkRequiredFields(address, data.RequiredFields); err != nil {
-		result = multierror.Append(result, err)
-	}
-
-	if err := checkAllowedFields(address, data.AllowedFields); err != nil {
-		result = multierror.Append(result, err)
-	}
-
-	if len(data.AdministrativeAreas) > 0 {
-		if adminAreaData, ok := data.AdministrativeAreas[data.DefaultLanguage]; ok {
-			if err := checkSubdivisions(address, adminAreaData); err != nil {
-				result = multierror.Append(result, err.(*multierror.Error).Errors...)
-			}
-		}
-	}
-
-	if address.PostCode != "" {
-		if err := checkPostCode(address, data.PostCodeRegex); err != nil {
-			result = multierror.Append(result, err.(*multierror.Error).Errors...)
-		}
-
-	}
-
-	return result.ErrorOrNil()
+	return defaultValidator.Validate(address)
 }
 
-func checkRequiredFields(address Address, required map[Field]struct{}) error {
-	errors := ErrMissingRequiredFields{
-		country: address.Country,
-	}
-
-	for field := range required {
-		switch field {
-		case Name:
-			if strings.TrimSpace(address.Name) == "" {
-				errors.Fields = append(errors.Fields, Name)
-			}
-		case Organization:
-			if strings.TrimSpace(address.Organization) == "" {
-				errors.Fields = append(errors.Fields, Organization)
-			}
-
-		case StreetAddress:
-			isEmpty := true
-			for _, line := range address.StreetAddress {
-				if strings.TrimSpace(line) != "" {
-					isEmpty = false
-					break
-				}
-			}
-			if isEmpty {
-				errors.Fields = append(errors.Fields, StreetAddress)
-			}
-
-		case DependentLocality:
-			if strings.TrimSpace(address.DependentLocality) == "" {
-				errors.Fields = append(errors.Fields, DependentLocality)
-			}
-
-		case Locality:
-			if strings.TrimSpace(address.Locality) == "" {
-				errors.Fields = append(errors.Fields, Locality)
-			}
-
-		case AdministrativeArea:
-			if strings.TrimSpace(address.AdministrativeArea) == "" {
-				errors.Fields = append(errors.Fields, AdministrativeArea)
-			}
+// ValidateField checks a single field of an address in a country,
+// using the other fields of the partial address for context. Only
+// errors for the field are returned. See Validator.ValidateField.
+func ValidateField(cc string, field Field, value string, partial Address) error {
+	return defaultValidator.ValidateField(cc, field, value, partial)
+}
 
-		case PostCode:
-			if strings.TrimSpace(address.PostCode) == "" {
-				errors.Fields = append(errors.Fields, PostCode)
-			}
+// defaultValidator validates addresses for Validate. Unlike a
+// Validator created using NewValidator, post codes only need to
+// contain a match for the regular expression of the country.
+var defaultValidator = &Validator{}
 
-		case SortingCode:
-			if strings.TrimSpace(address.SortingCode) == "" {
-				errors.Fields = append(errors.Fields, SortingCode)
+func isEmpty(address Address, field Field) bool {
+	if field == StreetAddress {
+		for _, line := range address.StreetAddress {
+			if strings.TrimSpace(line) != "" {
+				return false
 			}
 		}
+		return true
 	}
 
-	if len(errors.Fields) <= 0 {
-		return nil
-	}
-
-	return errors
+	return strings.TrimSpace(getField(address, field)) == ""
 }
 
-func checkAllowedFields(address Address, allowed map[Field]struct{}) error {
-	errors := ErrUnsupportedFields{
-		country: address.Country,
+func fieldValue(address Address, field Field) string {
+	if field == StreetAddress {
+		return strings.Join(address.StreetAddress, "\n")
 	}
 
-	if _, ok := allowed[Name]; address.Name != "" && !ok {
-		errors.Fields = append(errors.Fields, Name)
-	}
-
-	if _, ok := allowed[Organization]; address.Organization != "" && !ok {
-		errors.Fields = append(errors.Fields, Organization)
-	}
-
-	if _, ok := allowed[StreetAddress]; len(address.StreetAddress) > 0 && !ok {
-		errors.Fields = append(errors.Fields, StreetAddress)
-	}
-
-	if _, ok := allowed[DependentLocality]; address.DependentLocality != "" && !ok {
-		errors.Fields = append(errors.Fields, DependentLocality)
-	}
-
-	if _, ok := allowed[Locality]; address.Locality != "" && !ok {
-		errors.Fields = append(errors.Fields, Locality)
-	}
+	return getField(address, field)
+}
 
-	if _, ok := allowed[AdministrativeArea]; address.AdministrativeArea != "" && !ok {
-		errors.Fields = append(errors.Fields, AdministrativeArea)
-	}
+func checkRequiredFields(address Address, required map[Field]struct{}) ValidationErrors {
+	var errs ValidationErrors
 
-	if _, ok := allowed[PostCode]; address.PostCode != "" && !ok {
-		errors.Fields = append(errors.Fields, PostCode)
-	}
-
-	if _, ok := allowed[SortingCode]; address.SortingCode != "" && !ok {
-		errors.Fields = append(errors.Fields, SortingCode)
-	}
-
-	if len(errors.Fields) <= 0 {
-		return nil
+	for field := Name; field <= SortingCode; field++ {
+		if _, ok := required[field]; ok && isEmpty(address, field) {
+			errs = append(errs, &FieldError{
+				Field: field,
+				Code:  CodeMissing,
+			})
+		}
 	}
 
-	return errors
+	return errs
 }
 
-func checkSubdivisions(address Address, data administrativeAreaSlice) error {
-	var err *multierror.Error
+func checkAllowedFields(address Address, allowed map[Field]struct{}) ValidationErrors {
+	var errs ValidationErrors
 
-	if address.AdministrativeArea != "" {
-		adminAreaIndex := -1
-
-		for i, area := range data {
-			if area.ID == address.AdministrativeArea {
-				adminAreaIndex = i
-			}
+	for field := Name; field <= SortingCode; field++ {
+		if _, ok := allowed[field]; ok {
+			continue
 		}
 
-		if adminAreaIndex == -1 {
-			err = multierror.Append(err, ErrInvalidAdministrativeArea)
-			return err.ErrorOrNil()
+		if (field == StreetAddress && len(address.StreetAddress) > 0) || getField(address, field) != "" {
+			errs = append(errs, &FieldError{
+				Field: field,
+				Code:  CodeUnsupported,
+				Value: fieldValue(address, field),
+			})
 		}
+	}
 
-		localities := data[adminAreaIndex].Localities
-		localityIndex := -1
-
-		if address.Locality == "" || len(localities) <= 0 {
-			return err.ErrorOrNil()
-		}
+	return errs
+}
 
-		for i, locality := range localities {
-			if locality.ID == address.Locality {
-				localityIndex = i
-			}
-		}
+func invalidSubdivision(field Field, value string, err error) ValidationErrors {
+	return ValidationErrors{{
+		Field: field,
+		Code:  CodeInvalidValue,
+		Value: value,
+		Level: field,
+		Err:   err,
+	}}
+}
 
-		if localityIndex == -1 {
-			err = multierror.Append(err, ErrInvalidLocality)
-			return err.ErrorOrNil()
-		}
+// checkPostCodeWithPrefix checks the post code as entered, and
+// without its prefix, so that both PR 00901 and 00901 are valid.
+// If the post code is not valid for the country, post codes that
+// only differ in formatting are suggested.
+func checkPostCodeWithPrefix(address Address, data country, strict bool) ValidationErrors {
+	var errs ValidationErrors
 
-		dependentLocalities := localities[localityIndex].DependentLocalities
-		dependentLocalityIndex := -1
+	for i, postCode := range postCodeCandidates(data, address.PostCode) {
+		candidate := address
+		candidate.PostCode = postCode
 
-		if address.DependentLocality == "" || len(dependentLocalities) <= 0 {
-			return err.ErrorOrNil()
+		e := checkPostCode(candidate, data.PostCodeRegex, strict)
+		if e == nil {
+			return nil
 		}
 
-		for i, dl := range dependentLocalities {
-			if dl.ID == address.DependentLocality {
-				dependentLocalityIndex = i
-			}
+		if i == 0 {
+			errs = e
 		}
+	}
 
-		if dependentLocalityIndex == -1 {
-			err = multierror.Append(err, ErrInvalidDependentLocality)
-			return err.ErrorOrNil()
+	for _, err := range errs {
+		if err.Code == CodeInvalidValue {
+			err.Suggestions = suggestPostCodes(data, address.PostCode)
 		}
 	}
 
-	return err.ErrorOrNil()
+	return errs
 }
 
-func checkPostCode(address Address, regex postCodeRegex) error {
-	var err *multierror.Error
+// checkPostCode checks the post code against the regular expression
+// of the country and its subdivisions. In strict mode, the whole
+// post code must match the regular expression of the country.
+// Otherwise, it only needs to contain a match.
+func checkPostCode(address Address, regex postCodeRegex, strict bool) ValidationErrors {
+	if strict {
+		address.PostCode = strings.TrimSpace(address.PostCode)
+	}
 
 	if address.PostCode != "" && regex.regex != "" {
 		country := regex
-		countryRegex := regexp.MustCompile(country.regex)
+		countryRegex := compileRegex(country.regex)
+
+		if strict {
+			countryRegex = strictPostCodeRegex(country.regex)
+		}
 
 		if !countryRegex.MatchString(address.PostCode) {
-			err = multierror.Append(err, ErrInvalidPostCode)
-			return err.ErrorOrNil()
+			return ValidationErrors{{
+				Field: PostCode,
+				Code:  CodeInvalidValue,
+				Value: address.PostCode,
+				Err:   ErrInvalidPostCode,
+			}}
 		}
 
 		if area, ok := country.subdivisionRegex[address.AdministrativeArea]; ok {
-			areaRegex := regexp.MustCompile(area.regex)
-
-			if !areaRegex.MatchString(address.PostCode) {
-				err = multierror.Append(err, ErrInvalidPostCode)
-				return err.ErrorOrNil()
+			if !subdivisionPostCodeRegex(area.regex).MatchString(address.PostCode) {
+				return postCodeMismatch(address.PostCode, regex, AdministrativeArea, ErrPostCodeAdministrativeAreaMismatch)
 			}
 
 			if locality, ok := area.subdivisionRegex[address.Locality]; ok {
-				localityRegex := regexp.MustCompile(locality.regex)
-
-				if !localityRegex.MatchString(address.PostCode) {
-					err = multierror.Append(err, ErrInvalidPostCode)
-					return err.ErrorOrNil()
+				if !subdivisionPostCodeRegex(locality.regex).MatchString(address.PostCode) {
+					return postCodeMismatch(address.PostCode, regex, Locality, ErrPostCodeLocalityMismatch)
 				}
 
 				if dependentLocality, ok := locality.subdivisionRegex[address.DependentLocality]; ok {
-					dependentLocalityRegex := regexp.MustCompile(dependentLocality.regex)
-
-					if !dependentLocalityRegex.MatchString(address.PostCode) {
-						err = multierror.Append(err, ErrInvalidPostCode)
-						return err.ErrorOrNil()
+					if !subdivisionPostCodeRegex(dependentLocality.regex).MatchString(address.PostCode) {
+						return postCodeMismatch(address.PostCode, regex, DependentLocality, ErrPostCodeDependentLocalityMismatch)
 					}
 				}
 			}
 		}
 	}
 
-	return err.ErrorOrNil()
+	return nil
+}
+
+// postCodeMismatch returns the error for a post code that does not
+// belong to a subdivision, along with the subdivisions it belongs to.
+func postCodeMismatch(postCode string, regex postCodeRegex, level Field, err error) ValidationErrors {
+	return ValidationErrors{{
+		Field:     PostCode,
+		Code:      CodeMismatch,
+		Value:     postCode,
+		Level:     level,
+		Err:       err,
+		BelongsTo: inferSubdivisions(regex, postCode),
+	}}
 }
@@ -0,0 +1,384 @@
+package libaddress
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// Validator validates addresses. Its behavior can be changed using
+// ValidatorOptions. The zero value validates addresses in the same
+// way as Validate.
+type Validator struct {
+	strictPostCodes       bool
+	normalizePostCodes    bool
+	caseInsensitive       bool
+	acceptNames           bool
+	unsupportedAsWarnings bool
+	language              string
+	requiredFields        map[string]map[Field]struct{}
+	allowedCountries      map[string]struct{}
+}
+
+// ValidatorOption configures how addresses are
+// validated by a Validator.
+type ValidatorOption func(*Validator)
+
+// LenientPostCodes accepts post codes that contain a match for the
+// regular expression of the country, such as 123456 in Australia,
+// where post codes have 4 digits. By default, a Validator created
+// using NewValidator requires the whole post code to match.
+func LenientPostCodes() ValidatorOption {
+	return func(v *Validator) {
+		v.strictPostCodes = false
+	}
+}
+
+// NormalizePostCodes converts post codes into the form expected by the
+// postal operator using NormalizePostCode before they are validated,
+// so that 22162 1010 is accepted as 22162-1010 in the US.
+func NormalizePostCodes() ValidatorOption {
+	return func(v *Validator) {
+		v.normalizePostCodes = true
+	}
+}
+
+// CaseInsensitive matches administrative areas, localities
+// and dependent localities ignoring case.
+func CaseInsensitive() ValidatorOption {
+	return func(v *Validator) {
+		v.caseInsensitive = true
+	}
+}
+
+// AcceptNames accepts the names and postal keys of administrative
+// areas, localities and dependent localities as well as their IDs.
+func AcceptNames() ValidatorOption {
+	return func(v *Validator) {
+		v.acceptNames = true
+	}
+}
+
+// UnsupportedAsWarnings reports fields that are not supported by
+// the address format of the country as warnings instead of errors.
+// Warnings are returned by Check.
+func UnsupportedAsWarnings() ValidatorOption {
+	return func(v *Validator) {
+		v.unsupportedAsWarnings = true
+	}
+}
+
+// PreferLanguage sets the language whose list of administrative areas,
+// localities and dependent localities is checked first. Subdivisions
+// are also checked against the lists in the default language of the
+// country and the other languages it has subdivisions in.
+func PreferLanguage(language string) ValidatorOption {
+	return func(v *Validator) {
+		v.language = language
+	}
+}
+
+// RequiredFields overrides the fields that are required
+// for addresses in a country.
+func RequiredFields(cc string, fields ...Field) ValidatorOption {
+	return func(v *Validator) {
+		if v.requiredFields == nil {
+			v.requiredFields = map[string]map[Field]struct{}{}
+		}
+
+		required := map[Field]struct{}{}
+		for _, field := range fields {
+			required[field] = struct{}{}
+		}
+
+		v.requiredFields[countryCode(cc)] = required
+	}
+}
+
+// AllowedCountries restricts the countries that addresses can be
+// in. Addresses in any other country are invalid. The countries can
+// be given as ISO 3166-1 alpha-2, alpha-3 or numeric codes.
+func AllowedCountries(ccs ...string) ValidatorOption {
+	return func(v *Validator) {
+		if v.allowedCountries == nil {
+			v.allowedCountries = map[string]struct{}{}
+		}
+
+		for _, cc := range ccs {
+			v.allowedCountries[countryCode(cc)] = struct{}{}
+		}
+	}
+}
+
+// NewValidator creates a Validator configured with the options.
+// Post codes are validated in strict mode, unless LenientPostCodes
+// is used.
+func NewValidator(options ...ValidatorOption) *Validator {
+	v := &Validator{
+		strictPostCodes: true,
+	}
+	for _, option := range options {
+		option(v)
+	}
+	return v
+}
+
+// Validate checks an address to determine if it is valid.
+// If the address is invalid, the error is a ValidationErrors.
+func (v *Validator) Validate(address Address) error {
+	_, err := v.Check(address)
+	return err
+}
+
+// Check validates an address like Validate, but also returns
+// the problems that are reported as warnings. Warnings do not
+// make the address invalid.
+func (v *Validator) Check(address Address) (ValidationErrors, error) {
+	address.Country = countryCode(address.Country)
+
+	if !generated.hasCountry(address.Country) {
+		return nil, ValidationErrors{{
+			Field: Country,
+			Code:  CodeInvalidValue,
+			Value: address.Country,
+			Err:   ErrInvalidCountryCode,
+		}}
+	}
+
+	if _, ok := v.allowedCountries[address.Country]; v.allowedCountries != nil && !ok {
+		return nil, ValidationErrors{{
+			Field: Country,
+			Code:  CodeUnsupported,
+			Value: address.Country,
+			Err:   ErrUnsupportedCountry,
+		}}
+	}
+
+	var errs, warnings ValidationErrors
+	var data country = generated.getCountry(address.Country)
+
+	required := data.RequiredFields
+	if fields, ok := v.requiredFields[address.Country]; ok {
+		required = fields
+	}
+
+	errs = append(errs, checkRequiredFields(address, required)...)
+
+	if unsupported := checkAllowedFields(address, data.AllowedFields); v.unsupportedAsWarnings {
+		warnings = append(warnings, unsupported...)
+	} else {
+		errs = append(errs, unsupported...)
+	}
+
+	if len(data.AdministrativeAreas) > 0 {
+		var subdivisionErrs ValidationErrors
+
+		// The post code is checked against the
+		// subdivisions the address resolves to.
+		address, subdivisionErrs = v.checkSubdivisions(address, data)
+		errs = append(errs, subdivisionErrs...)
+	}
+
+	if address.PostCode != "" && v.normalizePostCodes {
+		if postCode, err := NormalizePostCode(address.Country, address.PostCode); err == nil {
+			address.PostCode = postCode
+		}
+	}
+
+	if address.PostCode != "" {
+		errs = append(errs, checkPostCodeWithPrefix(address, data, v.strictPostCodes)...)
+	}
+
+	return warnings, errs.ErrorOrNil()
+}
+
+// ValidateField checks a single field of an address as it is being
+// entered, such as in a form. The partial address provides the other
+// fields, such as the administrative area and locality a locality must
+// be in. Only errors for the field are returned, so missing or invalid
+// values in other fields are ignored. The lines of a street address
+// are separated by newlines.
+func (v *Validator) ValidateField(cc string, field Field, value string, partial Address) error {
+	address := partial
+	address.Country = countryCode(cc)
+
+	if field == StreetAddress {
+		address.StreetAddress = strings.Split(value, "\n")
+	} else {
+		setField(&address, field, value)
+	}
+
+	_, err := v.Check(address)
+
+	var validationErrors ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	// The field cannot be checked if the country is invalid,
+	// so errors for the country are always returned.
+	var errs ValidationErrors
+	for _, e := range validationErrors {
+		if e.Field == field || e.Field == Country {
+			errs = append(errs, e)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// checkSubdivisions checks the administrative area, locality and
+// dependent locality of an address against the lists of subdivisions
+// in each language of the country, until the address is valid in one
+// of them. The address is returned with the subdivisions replaced by
+// their IDs. If the address is not valid in any language, the errors
+// for the first language are returned, along with suggestions for
+// the invalid subdivision.
+func (v *Validator) checkSubdivisions(address Address, data country) (Address, ValidationErrors) {
+	var firstErrs ValidationErrors
+	var firstResolved Address
+
+	for i, lang := range v.languages(data) {
+		resolved, errs := v.checkSubdivisionsIn(address, data, lang)
+		if errs == nil {
+			return resolved, nil
+		}
+
+		if i == 0 {
+			firstErrs, firstResolved = errs, resolved
+		}
+	}
+
+	for _, err := range firstErrs {
+		err.Suggestions = suggestSubdivisions(data, firstResolved, err.Field)
+	}
+
+	return address, firstErrs
+}
+
+// languages returns the languages the subdivisions of a
+// country are checked in.
+func (v *Validator) languages(data country) []string {
+	return subdivisionLanguages(data, v.language)
+}
+
+// subdivisionLanguages returns the languages a country has subdivisions
+// in. The preferred language and the default language of the country
+// come first, followed by the other languages in order.
+func subdivisionLanguages(data country, preferred string) []string {
+	var languages []string
+
+	if _, ok := data.AdministrativeAreas[preferred]; ok && preferred != data.DefaultLanguage {
+		languages = append(languages, preferred)
+	}
+
+	if _, ok := data.AdministrativeAreas[data.DefaultLanguage]; ok {
+		languages = append(languages, data.DefaultLanguage)
+	}
+
+	var others []string
+	for lang := range data.AdministrativeAreas {
+		if lang != preferred && lang != data.DefaultLanguage {
+			others = append(others, lang)
+		}
+	}
+
+	sort.Strings(others)
+
+	return append(languages, others...)
+}
+
+// checkSubdivisionsIn checks the subdivisions of an
+// address against the list in the language.
+func (v *Validator) checkSubdivisionsIn(address Address, data country, lang string) (Address, ValidationErrors) {
+	if address.AdministrativeArea == "" {
+		return address, nil
+	}
+
+	index := generated.subdivisionIndex(data.ID)
+
+	area, ok := index.administrativeArea(lang, address.AdministrativeArea)
+	if !ok && v.fuzzy() {
+		for _, a := range data.AdministrativeAreas[lang] {
+			if v.matches(address.AdministrativeArea, a.ID, a.Name, a.PostalKey) {
+				area, ok = index.administrativeArea(lang, a.ID)
+				break
+			}
+		}
+	}
+
+	if !ok {
+		return address, invalidSubdivision(AdministrativeArea, address.AdministrativeArea, ErrInvalidAdministrativeArea)
+	}
+
+	address.AdministrativeArea = area.area.ID
+
+	if address.Locality == "" || len(area.localities) <= 0 {
+		return address, nil
+	}
+
+	l, ok := area.localities[address.Locality]
+	if !ok && v.fuzzy() {
+		for _, candidate := range area.area.Localities {
+			if v.matches(address.Locality, candidate.ID, candidate.Name) {
+				l, ok = area.localities[candidate.ID]
+				break
+			}
+		}
+	}
+
+	if !ok {
+		return address, invalidSubdivision(Locality, address.Locality, ErrInvalidLocality)
+	}
+
+	address.Locality = l.locality.ID
+
+	if address.DependentLocality == "" || len(l.dependentLocalities) <= 0 {
+		return address, nil
+	}
+
+	dl, ok := l.dependentLocalities[address.DependentLocality]
+	if !ok && v.fuzzy() {
+		for _, candidate := range l.locality.DependentLocalities {
+			if v.matches(address.DependentLocality, candidate.ID, candidate.Name) {
+				dl, ok = l.dependentLocalities[candidate.ID]
+				break
+			}
+		}
+	}
+
+	if !ok {
+		return address, invalidSubdivision(DependentLocality, address.DependentLocality, ErrInvalidDependentLocality)
+	}
+
+	address.DependentLocality = dl.ID
+
+	return address, nil
+}
+
+// fuzzy reports whether subdivisions can match
+// values other than their exact IDs.
+func (v *Validator) fuzzy() bool {
+	return v.caseInsensitive || v.acceptNames
+}
+
+// matches checks if the value matches the ID of a subdivision,
+// or any of its names if names are accepted.
+func (v *Validator) matches(value, id string, names ...string) bool {
+	keys := []string{id}
+	if v.acceptNames {
+		keys = append(keys, names...)
+	}
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+
+		if key == value || (v.caseInsensitive && matchesFolded(value, key)) {
+			return true
+		}
+	}
+
+	return false
+}
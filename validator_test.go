@@ -0,0 +1,337 @@
+package libaddress
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidator(t *testing.T) {
+
+	tests := []struct {
+		Options []ValidatorOption
+		Address Address
+		Valid   bool
+	}{
+		{
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("vic"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: false,
+		},
+		{
+			Options: []ValidatorOption{CaseInsensitive()},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("vic"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{CaseInsensitive()},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("vic"),
+				WithPostCode("2000"), // Post code in NSW
+				WithCountry("AU"),
+			),
+			Valid: false,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames()},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("Victoria"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames()},
+			Address: New(
+				WithStreetAddress([]string{"No.1 Jianguomenwai Avenue"}),
+				WithDependentLocality("临翔区"),
+				WithLocality("临沧市"),
+				WithAdministrativeArea("云南省"),
+				WithPostCode("677000"),
+				WithCountry("CN"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames()},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("victoria"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: false,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames(), CaseInsensitive()},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("victoria"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames()},
+			Address: New(
+				WithStreetAddress([]string{"1 Rue des Carrières"}),
+				WithLocality("Québec"),
+				WithAdministrativeArea("Québec"),
+				WithPostCode("G1R 4S9"),
+				WithCountry("CA"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames(), PreferLanguage("en")},
+			Address: New(
+				WithStreetAddress([]string{"Jangnyang-ro 17beon-gil"}),
+				WithDependentLocality("Buk-gu"),
+				WithLocality("Pohang-si"),
+				WithAdministrativeArea("Gyeongsangbuk-do"),
+				WithPostCode("37554"),
+				WithCountry("KR"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames(), CaseInsensitive()},
+			Address: New(
+				WithStreetAddress([]string{"No.1 Jianguomenwai Avenue"}),
+				WithDependentLocality("linxiang qu"),
+				WithLocality("lincang shi"),
+				WithAdministrativeArea("yunnan sheng"),
+				WithPostCode("677000"),
+				WithCountry("CN"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AcceptNames()},
+			Address: New(
+				WithStreetAddress([]string{"Jangnyang-ro 17beon-gil"}),
+				WithDependentLocality("Buk-gu"),
+				WithLocality("Pohang-si"),
+				WithAdministrativeArea("Gyeongsangbuk-do"),
+				WithPostCode("38100"), // Post code in 대구
+				WithCountry("KR"),
+			),
+			Valid: false,
+		},
+		{
+			Options: []ValidatorOption{UnsupportedAsWarnings()},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithDependentLocality("Toorak"), // Extraneous field
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{RequiredFields("au", StreetAddress, Locality, AdministrativeArea, PostCode, Name)},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: false,
+		},
+		{
+			Options: []ValidatorOption{RequiredFields("AU", StreetAddress)},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithCountry("AU"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AllowedCountries("NZ", "au")},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: true,
+		},
+		{
+			Options: []ValidatorOption{AllowedCountries("NZ")},
+			Address: New(
+				WithStreetAddress([]string{"525 Collins Street"}),
+				WithLocality("Melbourne"),
+				WithAdministrativeArea("VIC"),
+				WithPostCode("3000"),
+				WithCountry("AU"),
+			),
+			Valid: false,
+		},
+	}
+
+	for i, testCase := range tests {
+		err := NewValidator(testCase.Options...).Validate(testCase.Address)
+
+		if testCase.Valid && err != nil {
+			t.Errorf("Unexpected error validating address in test case %d: %s", i, err)
+		}
+
+		if !testCase.Valid && err == nil {
+			t.Errorf("Expected an error when validating address in test case %d, but there was no error", i)
+		}
+	}
+}
+
+func TestValidatorWarnings(t *testing.T) {
+
+	warnings, err := NewValidator(UnsupportedAsWarnings()).Check(New(
+		WithStreetAddress([]string{"525 Collins Street"}),
+		WithDependentLocality("Toorak"), // Extraneous field
+		WithLocality("Melbourne"),
+		WithAdministrativeArea("VIC"),
+		WithPostCode("3000"),
+		WithCountry("AU"),
+	))
+
+	if err != nil {
+		t.Fatalf("Unexpected error validating address: %s", err)
+	}
+
+	if len(warnings) != 1 || warnings[0].Field != DependentLocality || warnings[0].Code != CodeUnsupported {
+		t.Errorf("Expected an unsupported warning for the dependent locality, got %v", warnings)
+	}
+
+	err = NewValidator(AllowedCountries("NZ")).Validate(New(WithCountry("AU")))
+
+	if !errors.Is(err, ErrUnsupportedCountry) {
+		t.Errorf("Expected ErrUnsupportedCountry when validating an address in a country that is not allowed, got %v", err)
+	}
+}
+
+func TestValidateField(t *testing.T) {
+
+	tests := []struct {
+		Country string
+		Field   Field
+		Value   string
+		Partial Address
+		Code    ErrorCode
+		Err     error
+	}{
+		{
+			Country: "AU",
+			Field:   AdministrativeArea,
+			Value:   "VIC",
+			Partial: New(WithLocality("Melbourne")), // Missing street address
+		},
+		{
+			Country: "AU",
+			Field:   AdministrativeArea,
+			Value:   "ASDF",
+			Code:    CodeInvalidValue,
+			Err:     ErrInvalidAdministrativeArea,
+		},
+		{
+			Country: "AU",
+			Field:   AdministrativeArea,
+			Value:   "",
+			Code:    CodeMissing,
+		},
+		{
+			Country: "AU",
+			Field:   DependentLocality,
+			Value:   "Toorak",
+			Code:    CodeUnsupported,
+		},
+		{
+			Country: "CN",
+			Field:   Locality,
+			Value:   "临沧市",
+			Partial: New(WithAdministrativeArea("53")),
+		},
+		{
+			Country: "CN",
+			Field:   Locality,
+			Value:   "临沧市",
+			Partial: New(WithAdministrativeArea("11")),
+			Code:    CodeInvalidValue,
+			Err:     ErrInvalidLocality,
+		},
+		{
+			Country: "AU",
+			Field:   PostCode,
+			Value:   "3000",
+			Partial: New(WithAdministrativeArea("VIC")),
+		},
+		{
+			Country: "AU",
+			Field:   PostCode,
+			Value:   "2000",
+			Partial: New(WithAdministrativeArea("VIC")),
+			Code:    CodeMismatch,
+			Err:     ErrPostCodeAdministrativeAreaMismatch,
+		},
+		{
+			Country: "AU",
+			Field:   PostCode,
+			Value:   "300O",
+			Code:    CodeInvalidValue,
+			Err:     ErrInvalidPostCode,
+		},
+		{
+			Country: "AU",
+			Field:   StreetAddress,
+			Value:   "Level 1\n525 Collins Street",
+		},
+		{
+			Country: "XX",
+			Field:   PostCode,
+			Value:   "3000",
+			Code:    CodeInvalidValue,
+			Err:     ErrInvalidCountryCode,
+		},
+	}
+
+	for i, testCase := range tests {
+		err := ValidateField(testCase.Country, testCase.Field, testCase.Value, testCase.Partial)
+
+		if testCase.Code == "" {
+			if err != nil {
+				t.Errorf("Unexpected error validating field in test case %d: %s", i, err)
+			}
+			continue
+		}
+
+		var validationErrors ValidationErrors
+		if !errors.As(err, &validationErrors) || len(validationErrors) != 1 {
+			t.Errorf("Expected a single validation error in test case %d, got %v", i, err)
+			continue
+		}
+
+		if validationErrors[0].Code != testCase.Code || validationErrors[0].Err != testCase.Err {
+			t.Errorf("Validation error in test case %d does not match expected error, got %s", i, validationErrors[0])
+		}
+	}
+}
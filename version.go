@@ -0,0 +1,60 @@
+package libaddress
+
+import (
+	"fmt"
+	"time"
+)
+
+// DataVersionInfo describes the snapshot of Google's Address Data
+// Service that the address data was generated from.
+type DataVersionInfo struct {
+	// GeneratedAt is the time the data was generated in UTC. If the
+	// data was edited by hand, it is the time the version of the
+	// edited data was recorded (see Modified).
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// SourceURL is the URL the data was downloaded from.
+	SourceURL string `json:"source_url"`
+
+	// Countries is the number of countries in the data.
+	Countries int `json:"countries"`
+
+	// Hash is the hex encoded SHA-256 hash of data.generated.go,
+	// which changes whenever the data changes.
+	Hash string `json:"hash"`
+
+	// Modified is set if data.generated.go was edited after it was
+	// generated, in which case the data does not exactly match the
+	// source. After editing the data by hand, run the generator with
+	// the -version-only flag to update the hash and set Modified.
+	Modified bool `json:"modified"`
+}
+
+// DataVersion returns the version of the address data, which
+// can be reported in health checks and used to correlate
+// changes in validation with updates to the data.
+func DataVersion() DataVersionInfo {
+	return generatedVersion
+}
+
+// String returns the date the data was generated and the start of its
+// hash, such as 2020-03-10 (3f5cf42515f2). Data that was edited after
+// it was generated is marked as modified, such as
+// 2020-03-10 (3f5cf42515f2, modified).
+func (v DataVersionInfo) String() string {
+	date := "unknown"
+	if !v.GeneratedAt.IsZero() {
+		date = v.GeneratedAt.Format("2006-01-02")
+	}
+
+	hash := v.Hash
+	if len(hash) > 12 {
+		hash = hash[:12]
+	}
+
+	if v.Modified {
+		hash += ", modified"
+	}
+
+	return fmt.Sprintf("%s (%s)", date, hash)
+}
@@ -0,0 +1,58 @@
+package libaddress
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestDataVersion(t *testing.T) {
+
+	version := DataVersion()
+
+	if version.GeneratedAt.IsZero() {
+		t.Errorf("Expected data version to have a generation time")
+	}
+
+	if version.SourceURL == "" {
+		t.Errorf("Expected data version to have a source URL")
+	}
+
+	if countries := len(ListCountries("en")); version.Countries != countries {
+		t.Errorf("Expected data version to have %d countries, got %d", countries, version.Countries)
+	}
+
+	// The generator updates the hash whenever it regenerates the data.
+	// If data.generated.go is edited by hand, version.generated.go must
+	// be updated by running the generator with -version-only.
+	data, err := ioutil.ReadFile("data.generated.go")
+	if err != nil {
+		t.Fatalf("Error reading generated data: %s", err)
+	}
+
+	if hash := fmt.Sprintf("%x", sha256.Sum256(data)); version.Hash != hash {
+		t.Errorf("Data version hash does not match the generated data, expected %s, got %s", hash, version.Hash)
+	}
+
+	tests := []struct {
+		Version  DataVersionInfo
+		Expected string
+	}{
+		{
+			Version:  DataVersionInfo{GeneratedAt: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC), Hash: version.Hash},
+			Expected: "2020-03-10 (" + version.Hash[:12] + ")",
+		},
+		{
+			Version:  DataVersionInfo{Hash: version.Hash, Modified: true},
+			Expected: "unknown (" + version.Hash[:12] + ", modified)",
+		},
+	}
+
+	for i, testCase := range tests {
+		if s := testCase.Version.String(); s != testCase.Expected {
+			t.Errorf("Expected data version string in test case %d to be %s, got %s", i, testCase.Expected, s)
+		}
+	}
+}